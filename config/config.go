@@ -7,6 +7,7 @@ import (
 
 	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
@@ -20,18 +21,21 @@ import (
 type Config struct {
 	config.Common
 
-	trace          *trace.Driver
-	dialTimeout    time.Duration
-	connectionTTL  time.Duration
-	balancerConfig *balancerConfig.Config
-	secure         bool
-	endpoint       string
-	database       string
-	metaOptions    []meta.Option
-	grpcOptions    []grpc.DialOption
-	credentials    credentials.Credentials
-	tlsConfig      *tls.Config
-	meta           *meta.Meta
+	trace              *trace.Driver
+	dialTimeout        time.Duration
+	connectionTTL      time.Duration
+	balancerConfig     *balancerConfig.Config
+	secure             bool
+	endpoint           string
+	database           string
+	metaOptions        []meta.Option
+	grpcOptions        []grpc.DialOption
+	unaryInterceptors  []grpc.UnaryClientInterceptor
+	streamInterceptors []grpc.StreamClientInterceptor
+	compression        string
+	credentials        credentials.Credentials
+	tlsConfig          *tls.Config
+	meta               *meta.Meta
 
 	excludeGRPCCodesForPessimization []grpcCodes.Code
 }
@@ -47,10 +51,18 @@ func (c *Config) ExcludeGRPCCodesForPessimization() []grpcCodes.Code {
 
 // GrpcDialOptions reports about used grpc dialing options
 func (c *Config) GrpcDialOptions() []grpc.DialOption {
-	return append(
-		defaultGrpcOptions(c.trace, c.secure, c.tlsConfig),
-		c.grpcOptions...,
-	)
+	opts := defaultGrpcOptions(c.trace, c.secure, c.tlsConfig)
+	if len(c.unaryInterceptors) > 0 {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(c.unaryInterceptors...))
+	}
+	if len(c.streamInterceptors) > 0 {
+		opts = append(opts, grpc.WithChainStreamInterceptor(c.streamInterceptors...))
+	}
+	if c.compression != "" {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(c.compression)))
+	}
+
+	return append(opts, c.grpcOptions...)
 }
 
 // Meta reports meta information about database connection
@@ -143,6 +155,13 @@ func WithCertificate(certificate *x509.Certificate) Option {
 	}
 }
 
+// WithClientCertificate appends client certificate to TLS config for mutual TLS authentication
+func WithClientCertificate(certificate tls.Certificate) Option { //nolint:gocritic
+	return func(c *Config) {
+		c.tlsConfig.Certificates = append(c.tlsConfig.Certificates, certificate)
+	}
+}
+
 // WithTLSConfig replaces older TLS config
 //
 // Warning: all early changes of TLS config will be lost
@@ -278,6 +297,33 @@ func WithGrpcOptions(option ...grpc.DialOption) Option {
 	}
 }
 
+// WithUnaryClientInterceptor appends grpc unary client interceptors which are called
+// on each unary RPC of driver in order of registration
+func WithUnaryClientInterceptor(interceptors ...grpc.UnaryClientInterceptor) Option {
+	return func(c *Config) {
+		c.unaryInterceptors = append(c.unaryInterceptors, interceptors...)
+	}
+}
+
+// WithStreamClientInterceptor appends grpc stream client interceptors which are called
+// on each streaming RPC of driver in order of registration
+func WithStreamClientInterceptor(interceptors ...grpc.StreamClientInterceptor) Option {
+	return func(c *Config) {
+		c.streamInterceptors = append(c.streamInterceptors, interceptors...)
+	}
+}
+
+// GrpcCompressionGzip is a name of gzip compressor of grpc messages
+const GrpcCompressionGzip = gzip.Name
+
+// WithGrpcCompression enables compression of grpc messages with compressor registered
+// in google.golang.org/grpc/encoding by name (such as GrpcCompressionGzip)
+func WithGrpcCompression(name string) Option {
+	return func(c *Config) {
+		c.compression = name
+	}
+}
+
 func ExcludeGRPCCodesForPessimization(codes ...grpcCodes.Code) Option {
 	return func(c *Config) {
 		c.excludeGRPCCodesForPessimization = append(
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestClientInterceptors(t *testing.T) {
+	var (
+		calls      []string
+		errStopped = errors.New("stopped")
+	)
+	unary := func(name string) grpc.UnaryClientInterceptor {
+		return func(ctx context.Context, method string, req, reply interface{},
+			cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+		) error {
+			calls = append(calls, name+":"+method)
+
+			return errStopped
+		}
+	}
+	stream := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		calls = append(calls, "stream:"+method)
+
+		return nil, errStopped
+	}
+	c := New(
+		WithEndpoint("localhost:0"),
+		WithUnaryClientInterceptor(unary("first")),
+		WithUnaryClientInterceptor(unary("second")),
+		WithStreamClientInterceptor(stream),
+	)
+	cc, err := grpc.Dial(c.Endpoint(), c.GrpcDialOptions()...)
+	require.NoError(t, err)
+	defer cc.Close()
+
+	err = cc.Invoke(context.Background(), "/Ydb.Test/Unary", nil, nil)
+	require.ErrorIs(t, err, errStopped)
+	_, err = cc.NewStream(context.Background(), &grpc.StreamDesc{}, "/Ydb.Test/Stream")
+	require.ErrorIs(t, err, errStopped)
+	// first interceptor does not call invoker, so second one is not called
+	require.Equal(t, []string{"first:/Ydb.Test/Unary", "stream:/Ydb.Test/Stream"}, calls)
+}
+
+func TestGrpcCompression(t *testing.T) {
+	var compressors []string
+	c := New(
+		WithEndpoint("localhost:0"),
+		WithGrpcCompression(GrpcCompressionGzip),
+		WithUnaryClientInterceptor(func(ctx context.Context, method string, req, reply interface{},
+			cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+		) error {
+			for _, opt := range opts {
+				if o, ok := opt.(grpc.CompressorCallOption); ok {
+					compressors = append(compressors, o.CompressorType)
+				}
+			}
+
+			return nil
+		}),
+	)
+	cc, err := grpc.Dial(c.Endpoint(), c.GrpcDialOptions()...)
+	require.NoError(t, err)
+	defer cc.Close()
+
+	require.NoError(t, cc.Invoke(context.Background(), "/Ydb.Test/Unary", nil, nil))
+	require.Equal(t, []string{"gzip"}, compressors)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 )
 
@@ -19,3 +20,13 @@ func WithOperationTimeout(ctx context.Context, operationTimeout time.Duration) c
 func WithOperationCancelAfter(ctx context.Context, operationCancelAfter time.Duration) context.Context {
 	return operation.WithCancelAfter(ctx, operationCancelAfter)
 }
+
+// WithPreferredNodeID returns a copy of parent context with preferred YDB node for requests.
+//
+// Table client prefers idle sessions on this node and creates new sessions on this node
+// (if node is available), so it may be used for data locality with session NodeID():
+//
+//	ctx = ydb.WithPreferredNodeID(ctx, s.NodeID())
+func WithPreferredNodeID(ctx context.Context, nodeID uint32) context.Context {
+	return balancer.WithNodeID(ctx, nodeID)
+}
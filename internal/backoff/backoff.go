@@ -111,6 +111,130 @@ func (b logBackoff) Delay(i int) time.Duration {
 	return f + time.Duration(b.r.Int64(int64(d-f)+1))
 }
 
+var _ Backoff = constantBackoff(0)
+
+// constantBackoff contains constant Backoff policy.
+type constantBackoff time.Duration
+
+// Constant returns Backoff with the same delay for each attempt.
+func Constant(delay time.Duration) constantBackoff {
+	return constantBackoff(delay)
+}
+
+// Delay returns mapping of i to Delay.
+func (b constantBackoff) Delay(int) time.Duration {
+	return time.Duration(b)
+}
+
+var _ Backoff = fibonacciBackoff{}
+
+// fibonacciBackoff contains Backoff policy with delays growing as Fibonacci numbers.
+type fibonacciBackoff struct {
+	// slotDuration is a size of a single time slot used in Backoff Delay calculation.
+	// If slotDuration is less or equal to zero, then the time.Second value is used.
+	slotDuration time.Duration
+
+	// ceiling is a 1-based position of maximum Fibonacci number used in Backoff Delay calculation
+	// (ceiling of 6 limits delays with 8 slots). If ceiling is less or equal to zero,
+	// then the default ceiling of 1 is used.
+	ceiling uint
+}
+
+// Fibonacci returns Backoff with delays growing as slotDuration multiplied to Fibonacci numbers
+// (1, 1, 2, 3, 5, ...) up to ceiling-th number (counting from 1).
+func Fibonacci(slotDuration time.Duration, ceiling uint) fibonacciBackoff {
+	return fibonacciBackoff{
+		slotDuration: slotDuration,
+		ceiling:      ceiling,
+	}
+}
+
+// Delay returns mapping of i to Delay.
+func (b fibonacciBackoff) Delay(i int) time.Duration {
+	s := b.slotDuration
+	if s <= 0 {
+		s = time.Second
+	}
+	const maxDelay = time.Duration(math.MaxInt64)
+	n := min(uint(i), max(1, b.ceiling)-1)
+	prev, curr := time.Duration(0), time.Duration(1)
+	for ; n > 0; n-- {
+		if curr > maxDelay/s-prev {
+			// next Fibonacci number multiplied to slot overflows time.Duration
+			return maxDelay
+		}
+		prev, curr = curr, prev+curr
+	}
+
+	return s * curr
+}
+
+var _ Backoff = decorrelatedJitterBackoff{}
+
+// decorrelatedJitterBackoff contains "decorrelated jitter" Backoff policy
+// (sleep = min(ceiling, random_between(base, previous_sleep * 3))).
+// Each retryer gets independent random schedule, so retries of many clients
+// are not synchronized after common failure.
+type decorrelatedJitterBackoff struct {
+	// base is a minimal Backoff Delay.
+	// If base is less or equal to zero, then the time.Second value is used.
+	base time.Duration
+
+	// ceiling is a maximum Backoff Delay.
+	// If ceiling is less than base, then base is used as ceiling.
+	ceiling time.Duration
+
+	// generator of jitter
+	r xrand.Rand
+}
+
+// DecorrelatedJitter returns Backoff with "decorrelated jitter" policy
+func DecorrelatedJitter(base, ceiling time.Duration, opts ...decorrelatedJitterOption) decorrelatedJitterBackoff {
+	b := decorrelatedJitterBackoff{
+		base:    base,
+		ceiling: ceiling,
+		r:       xrand.New(xrand.WithLock()),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&b)
+		}
+	}
+
+	return b
+}
+
+type decorrelatedJitterOption func(b *decorrelatedJitterBackoff)
+
+func WithDecorrelatedJitterSeed(seed int64) decorrelatedJitterOption {
+	return func(b *decorrelatedJitterBackoff) {
+		b.r = xrand.New(xrand.WithLock(), xrand.WithSeed(seed))
+	}
+}
+
+// Delay returns mapping of i to Delay.
+// Delay is stateless, so previous sleeps of decorrelated schedule are sampled on each call.
+func (b decorrelatedJitterBackoff) Delay(i int) time.Duration {
+	base := b.base
+	if base <= 0 {
+		base = time.Second
+	}
+	ceiling := b.ceiling
+	if ceiling < base {
+		ceiling = base
+	}
+	d := base
+	for ; i >= 0; i-- {
+		upper := d * 3 //nolint:gomnd
+		if upper > ceiling || upper < d {
+			upper = ceiling
+		}
+		d = base + time.Duration(b.r.Int64(int64(upper-base)+1))
+	}
+
+	return d
+}
+
 func min(a, b uint) uint {
 	if a < b {
 		return a
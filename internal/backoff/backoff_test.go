@@ -2,6 +2,7 @@ package backoff
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"testing"
 	"time"
@@ -213,6 +214,40 @@ func TestFastSlowDelaysWithoutJitter(t *testing.T) {
 				64 * time.Second,
 			},
 		},
+		{
+			name:    "ConstantBackoff",
+			backoff: Constant(time.Second),
+			exp: []time.Duration{
+				time.Second,
+				time.Second,
+				time.Second,
+				time.Second,
+			},
+		},
+		{
+			name:    "FibonacciBackoff",
+			backoff: Fibonacci(time.Millisecond, 6),
+			exp: []time.Duration{
+				time.Millisecond,
+				time.Millisecond,
+				2 * time.Millisecond,
+				3 * time.Millisecond,
+				5 * time.Millisecond,
+				8 * time.Millisecond,
+				8 * time.Millisecond,
+				8 * time.Millisecond,
+				8 * time.Millisecond,
+			},
+		},
+		{
+			name:    "FibonacciBackoffDefaultCeiling",
+			backoff: Fibonacci(time.Millisecond, 0),
+			exp: []time.Duration{
+				time.Millisecond,
+				time.Millisecond,
+				time.Millisecond,
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			for n, exp := range tt.exp {
@@ -224,3 +259,36 @@ func TestFastSlowDelaysWithoutJitter(t *testing.T) {
 		})
 	}
 }
+
+func TestFibonacciBackoffOverflow(t *testing.T) {
+	b := Fibonacci(time.Second, math.MaxUint)
+	require.Equal(t, 55*time.Second, b.Delay(9))
+	prev := time.Duration(0)
+	for i := 0; i < 200; i++ {
+		d := b.Delay(i)
+		require.GreaterOrEqual(t, d, prev)
+		prev = d
+	}
+	require.Equal(t, time.Duration(math.MaxInt64), b.Delay(200))
+	require.Equal(t, time.Duration(math.MaxInt64), b.Delay(math.MaxInt))
+}
+
+func TestDecorrelatedJitterBackoff(t *testing.T) {
+	const (
+		base    = 10 * time.Millisecond
+		ceiling = time.Second
+	)
+	b := DecorrelatedJitter(base, ceiling, WithDecorrelatedJitterSeed(0))
+	for i := 0; i < 100; i++ {
+		d := b.Delay(i)
+		require.GreaterOrEqual(t, d, base)
+		require.LessOrEqual(t, d, ceiling)
+	}
+	require.LessOrEqual(t, b.Delay(0), 3*base)
+
+	delays := make(map[time.Duration]struct{})
+	for seed := int64(0); seed < 10; seed++ {
+		delays[DecorrelatedJitter(base, ceiling, WithDecorrelatedJitterSeed(seed)).Delay(5)] = struct{}{}
+	}
+	require.Greater(t, len(delays), 1)
+}
@@ -59,6 +59,19 @@ func (b *Balancer) HasNode(id uint32) bool {
 	return false
 }
 
+// NodeIDs returns IDs of nodes with connections in current connections state
+func (b *Balancer) NodeIDs() []uint32 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	ids := make([]uint32, 0, len(b.connectionsState.connByNodeID))
+	for id := range b.connectionsState.connByNodeID {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
 func (b *Balancer) OnUpdate(onApplyDiscoveredEndpoints func(ctx context.Context, endpoints []endpoint.Info)) {
 	b.mu.WithLock(func() {
 		b.onApplyDiscoveredEndpoints = append(b.onApplyDiscoveredEndpoints, onApplyDiscoveredEndpoints)
@@ -4,6 +4,7 @@ import "context"
 
 type (
 	ctxEndpointKey struct{}
+	nodeID         uint32
 )
 
 type Endpoint interface {
@@ -21,3 +22,12 @@ func ContextEndpoint(ctx context.Context) (e Endpoint, ok bool) {
 
 	return nil, false
 }
+
+func (id nodeID) NodeID() uint32 {
+	return uint32(id)
+}
+
+// WithNodeID returns context with preferred node for requests
+func WithNodeID(ctx context.Context, id uint32) context.Context {
+	return WithEndpoint(ctx, nodeID(id))
+}
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
@@ -142,6 +144,24 @@ func supportNewTypeLink(x interface{}) string {
 	return "https://github.com/ydb-platform/ydb-go-sdk/issues/new?" + v.Encode()
 }
 
+// toTypedYdbParam makes parameter of YQL type overridden in name of argument,
+// e.g. sql.Named("release_date,Date", time.Now()). Go values are converted with the
+// same rules as in table.ParamsFromMap
+func toTypedYdbParam(name, typeName string, value interface{}) (*params.Parameter, error) {
+	if name == "" {
+		return nil, xerrors.WithStackTrace(errUnnamedParam)
+	}
+	parameters, err := table.ParamsFromMap(
+		map[string]interface{}{name: value},
+		map[string]string{name: typeName},
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return (*parameters)[0], nil
+}
+
 func toYdbParam(name string, value interface{}) (*params.Parameter, error) {
 	if na, ok := value.(driver.NamedValue); ok {
 		n, v := na.Name, na.Value
@@ -160,6 +180,9 @@ func toYdbParam(name string, value interface{}) (*params.Parameter, error) {
 	if v, ok := value.(*params.Parameter); ok {
 		return v, nil
 	}
+	if n, typeName, hasTypeName := strings.Cut(name, ","); hasTypeName {
+		return toTypedYdbParam(n, typeName, value)
+	}
 	v, err := toValue(value)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
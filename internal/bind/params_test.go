@@ -375,6 +375,26 @@ func TestYdbParam(t *testing.T) {
 			dst: nil,
 			err: errUnnamedParam,
 		},
+		{
+			src: named("a,Uint64", int(42)),
+			dst: params.Named("$a", types.Uint64Value(42)),
+			err: nil,
+		},
+		{
+			src: sql.Named("$release_date,Date", time.Date(2006, time.February, 3, 0, 0, 0, 0, time.UTC)),
+			dst: params.Named("$release_date", types.DateValueFromTime(time.Date(2006, time.February, 3, 0, 0, 0, 0, time.UTC))),
+			err: nil,
+		},
+		{
+			src: named("comment,Utf8", nil),
+			dst: params.Named("$comment", types.NullValue(types.TypeText)),
+			err: nil,
+		},
+		{
+			src: named(",Uint64", int(42)),
+			dst: nil,
+			err: errUnnamedParam,
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			dst, err := toYdbParam("", tt.src)
@@ -399,6 +399,18 @@ func not(x *big.Int) {
 	}
 }
 
+// FromRat converts rational x into decimal integer representation with given precision and scale.
+// It returns error if x cannot be represented exactly.
+func FromRat(x *big.Rat, precision, scale uint32) (*big.Int, error) {
+	v := big.NewInt(0).Mul(x.Num(), pow(ten, scale))
+	v, rem := v.QuoRem(v, x.Denom(), big.NewInt(0))
+	if rem.Sign() != 0 || v.CmpAbs(pow(ten, precision)) >= 0 {
+		return nil, precisionError(x.RatString(), precision, scale)
+	}
+
+	return v, nil
+}
+
 // pow returns new instance of big.Int equal to x^n.
 func pow(x *big.Int, n uint32) *big.Int {
 	var (
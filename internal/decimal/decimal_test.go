@@ -386,3 +386,33 @@ func oldParse(s string, precision, scale uint32) (*big.Int, error) {
 
 	return v, nil
 }
+
+func TestFromRat(t *testing.T) {
+	for _, tt := range []struct {
+		rat       string
+		precision uint32
+		scale     uint32
+		exp       *big.Int
+		err       bool
+	}{
+		{rat: "-123.45", precision: 22, scale: 2, exp: big.NewInt(-12345)},
+		{rat: "1/4", precision: 22, scale: 9, exp: big.NewInt(250000000)},
+		{rat: "1/3", precision: 22, scale: 9, err: true},
+		{rat: "12345", precision: 4, scale: 0, err: true},
+	} {
+		t.Run(tt.rat, func(t *testing.T) {
+			r, ok := new(big.Rat).SetString(tt.rat)
+			require.True(t, ok)
+			v, err := FromRat(r, tt.precision, tt.scale)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, v)
+			d := Decimal{Bytes: BigIntToByte(v, tt.precision, tt.scale), Precision: tt.precision, Scale: tt.scale}
+			require.Equal(t, 0, r.Cmp(d.Rat()))
+		})
+	}
+}
@@ -17,3 +17,14 @@ func (d *Decimal) String() string {
 func (d *Decimal) BigInt() *big.Int {
 	return FromInt128(d.Bytes, d.Precision, d.Scale)
 }
+
+// Rat returns exact rational representation of decimal value.
+// Special values (inf, nan) are returned as is without scale.
+func (d *Decimal) Rat() *big.Rat {
+	v := d.BigInt()
+	if IsInf(v) || IsNaN(v) || IsErr(v) {
+		return new(big.Rat).SetInt(v)
+	}
+
+	return new(big.Rat).SetFrac(v, pow(ten, d.Scale))
+}
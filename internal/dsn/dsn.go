@@ -3,14 +3,22 @@ package dsn
 import (
 	"fmt"
 	"net/url"
+	"os"
+	"strings"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
 var (
 	insecureSchema = "grpc"
 	databaseParam  = "database"
+	tokenParam     = "token"
+	tokenFileParam = "token_file"
+	balancerParams = []string{"go_balancer", "balancer"}
 )
 
 type UserInfo struct {
@@ -51,6 +59,37 @@ func Parse(dsn string) (info parsedInfo, err error) {
 		)
 		delete(info.Params, databaseParam)
 	}
+	if token := info.Params.Get(tokenParam); token != "" {
+		info.Options = append(info.Options,
+			config.WithCredentials(credentials.NewAccessTokenCredentials(token)),
+		)
+	} else if tokenFile := info.Params.Get(tokenFileParam); tokenFile != "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return info, xerrors.WithStackTrace(fmt.Errorf("read token file '%s' failed: %w", tokenFile, err))
+		}
+		info.Options = append(info.Options,
+			config.WithCredentials(credentials.NewAccessTokenCredentials(strings.TrimSpace(string(token)))),
+		)
+	}
+	for _, param := range balancerParams {
+		if balancer := info.Params.Get(param); balancer != "" {
+			info.Options = append(info.Options, config.WithBalancer(balancerFromParam(balancer)))
+
+			break
+		}
+	}
 
 	return info, nil
 }
+
+// balancerFromParam makes balancer from name of balancer type (e.g. "random_choice"),
+// "prefer_local" alias or JSON config of balancer (see balancers.FromConfig)
+func balancerFromParam(balancer string) *balancerConfig.Config {
+	switch balancer {
+	case "prefer_local", "prefer_local_dc":
+		return balancers.PreferLocalDCWithFallBack(balancers.RandomChoice())
+	default:
+		return balancers.FromConfig(balancer)
+	}
+}
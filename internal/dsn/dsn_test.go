@@ -1,11 +1,16 @@
 package dsn
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 )
 
 func TestParseConnectionString(t *testing.T) {
@@ -124,3 +129,61 @@ func TestParseConnectionString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseConnectionStringParams(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0o600))
+	for _, tt := range []struct {
+		name     string
+		dsn      string
+		token    string
+		balancer *balancerConfig.Config
+		err      bool
+	}{
+		{
+			name:  "Token",
+			dsn:   "grpcs://localhost:2135/local?token=secret",
+			token: "secret",
+		},
+		{
+			name:  "TokenFile",
+			dsn:   "grpcs://localhost:2135/local?token_file=" + tokenFile,
+			token: "file-token",
+		},
+		{
+			name: "TokenFileNotExists",
+			dsn:  "grpcs://localhost:2135/local?token_file=" + tokenFile + ".not_exists",
+			err:  true,
+		},
+		{
+			name:     "Balancer",
+			dsn:      "grpcs://localhost:2135/?database=/local&balancer=round_robin",
+			balancer: balancers.RoundRobin(),
+		},
+		{
+			name:     "PreferLocalBalancer",
+			dsn:      "grpcs://localhost:2135/?database=/local&balancer=prefer_local",
+			balancer: balancers.PreferLocalDCWithFallBack(balancers.RandomChoice()),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := Parse(tt.dsn)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			c := config.New(info.Options...)
+			require.Equal(t, "/local", c.Database())
+			if tt.token != "" {
+				token, err := c.Credentials().Token(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, tt.token, token)
+			}
+			if tt.balancer != nil {
+				require.Equal(t, tt.balancer.String(), c.Balancer().String())
+			}
+		})
+	}
+}
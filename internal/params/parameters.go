@@ -2,6 +2,7 @@ package params
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -60,6 +61,27 @@ func (p *Parameters) String() string {
 	return buffer.String()
 }
 
+// Yql returns parameters as YQL statements `$name = <literal>;`, one per line,
+// which can be used instead of DECLARE statements of query for reproducing query in console
+func (p *Parameters) Yql() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+
+	if p != nil {
+		for _, param := range *p {
+			if !strings.HasPrefix(param.name, "$") {
+				buffer.WriteByte('$')
+			}
+			buffer.WriteString(param.name)
+			buffer.WriteString(" = ")
+			buffer.WriteString(param.value.Yql())
+			buffer.WriteString(";\n")
+		}
+	}
+
+	return buffer.String()
+}
+
 func (p *Parameters) ToYDB(a *allocator.Allocator) map[string]*Ydb.TypedValue {
 	if p == nil {
 		return nil
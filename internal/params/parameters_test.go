@@ -17,6 +17,15 @@ func TestParameter(t *testing.T) {
 	require.Equal(t, "DECLARE x AS Utf8", Declare(p))
 }
 
+func TestParametersYql(t *testing.T) {
+	p := &Parameters{}
+	p.Add(
+		Named("$x", value.TextValue("X")),
+		Named("y", value.Int32Value(1)),
+	)
+	require.Equal(t, "$x = \"X\"u;\n$y = 1;\n", p.Yql())
+}
+
 func TestParameters(t *testing.T) {
 	p := &Parameters{}
 	p.Add(
@@ -24,6 +33,7 @@ func TestParameters(t *testing.T) {
 		Named("y", value.TextValue("Y")),
 	)
 	require.Equal(t, "{\"x\":\"X\"u,\"y\":\"Y\"u}", p.String())
+	require.Equal(t, "$x = \"X\"u;\n$y = \"Y\"u;\n", p.Yql())
 	require.Equal(t, 2, p.Count())
 	visited := make(map[string]value.Value, 2)
 	p.Each(func(name string, v value.Value) {
@@ -56,6 +66,7 @@ func TestNil(t *testing.T) {
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			require.Equal(t, "{}", tt.p.String())
+			require.Equal(t, "", tt.p.Yql())
 			require.Equal(t, 0, tt.p.Count())
 			visited := make(map[string]value.Value, 1)
 			tt.p.Each(func(name string, v value.Value) {
@@ -15,6 +15,7 @@ func (p pgParam) Unknown(val string) Builder {
 	return p.Value(pg.OIDUnknown, val)
 }
 
+// Value makes parameter of PostgreSQL type with given OID from text representation
 func (p pgParam) Value(oid uint32, val string) Builder {
 	p.param.value = value.PgValue(oid, val)
 	p.param.parent.params = append(p.param.parent.params, p.param)
@@ -22,6 +23,26 @@ func (p pgParam) Value(oid uint32, val string) Builder {
 	return p.param.parent
 }
 
+// Binary makes parameter of PostgreSQL type with given OID from binary representation
+func (p pgParam) Binary(oid uint32, val []byte) Builder {
+	p.param.value = value.PgBinaryValue(oid, val)
+	p.param.parent.params = append(p.param.parent.params, p.param)
+
+	return p.param.parent
+}
+
+func (p pgParam) Bool(val bool) Builder {
+	if val {
+		return p.Value(pg.OIDBool, "t")
+	}
+
+	return p.Value(pg.OIDBool, "f")
+}
+
+func (p pgParam) Int2(val int16) Builder {
+	return p.Value(pg.OIDInt2, strconv.FormatInt(int64(val), 10))
+}
+
 func (p pgParam) Int4(val int32) Builder {
 	return p.Value(pg.OIDInt4, strconv.FormatInt(int64(val), 10))
 }
@@ -29,3 +50,15 @@ func (p pgParam) Int4(val int32) Builder {
 func (p pgParam) Int8(val int64) Builder {
 	return p.Value(pg.OIDInt8, strconv.FormatInt(val, 10))
 }
+
+func (p pgParam) Float4(val float32) Builder {
+	return p.Value(pg.OIDFloat4, strconv.FormatFloat(float64(val), 'g', -1, 32))
+}
+
+func (p pgParam) Float8(val float64) Builder {
+	return p.Value(pg.OIDFloat8, strconv.FormatFloat(val, 'g', -1, 64))
+}
+
+func (p pgParam) Text(val string) Builder {
+	return p.Value(pg.OIDText, val)
+}
@@ -74,6 +74,108 @@ func TestPg(t *testing.T) {
 				},
 			},
 		},
+		{
+			method: "Bool",
+			args:   []any{true},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDBool,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: "t"},
+				},
+			},
+		},
+		{
+			method: "Int2",
+			args:   []any{int16(123)},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDInt2,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: "123"},
+				},
+			},
+		},
+		{
+			method: "Float4",
+			args:   []any{float32(1.5)},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDFloat4,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: "1.5"},
+				},
+			},
+		},
+		{
+			method: "Float8",
+			args:   []any{float64(1.5)},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDFloat8,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: "1.5"},
+				},
+			},
+		},
+		{
+			method: "Text",
+			args:   []any{"abc"},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDText,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_TextValue{TextValue: "abc"},
+				},
+			},
+		},
+		{
+			method: "Binary",
+			args:   []any{uint32(pg.OIDBytea), []byte{1, 2}},
+
+			expected: expected{
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_PgType{
+						PgType: &Ydb.PgType{
+							Oid: pg.OIDBytea,
+						},
+					},
+				},
+				Value: &Ydb.Value{
+					Value: &Ydb.Value_BytesValue{BytesValue: []byte{1, 2}},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
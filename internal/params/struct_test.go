@@ -837,7 +837,7 @@ func TestStruct(t *testing.T) {
 						Items: []*Ydb.Value{
 							{
 								Value: &Ydb.Value_TextValue{
-									TextValue: "1973-11-29T21:33:09Z",
+									TextValue: "1973-11-29T21:33:09,UTC",
 								},
 							},
 						},
@@ -870,7 +870,7 @@ func TestStruct(t *testing.T) {
 						Items: []*Ydb.Value{
 							{
 								Value: &Ydb.Value_TextValue{
-									TextValue: "1973-11-29",
+									TextValue: "1973-11-29,UTC",
 								},
 							},
 						},
@@ -903,7 +903,7 @@ func TestStruct(t *testing.T) {
 						Items: []*Ydb.Value{
 							{
 								Value: &Ydb.Value_TextValue{
-									TextValue: "1973-11-29T21:33:09.000000Z",
+									TextValue: "1973-11-29T21:33:09.000000,UTC",
 								},
 							},
 						},
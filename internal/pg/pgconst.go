@@ -3,7 +3,13 @@ package pg
 const (
 	// https://github.com/postgres/postgres/blob/master/src/include/catalog/pg_type.dat
 
+	OIDBool    = 16
+	OIDBytea   = 17
+	OIDInt2    = 21
 	OIDInt4    = 23
 	OIDInt8    = 20
+	OIDText    = 25
+	OIDFloat4  = 700
+	OIDFloat8  = 701
 	OIDUnknown = 705
 )
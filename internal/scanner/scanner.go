@@ -143,3 +143,9 @@ type Scanner interface {
 	// UnmarshalYDB must be implemented on client-side for unmarshal raw ydb value.
 	UnmarshalYDB(raw RawValue) error
 }
+
+// YSONUnmarshaler decodes Yson values with pluggable decoder (for example, go.ytsaurus.tech/yt/go/yson).
+// UnmarshalYSON is called with nil data for NULL values.
+type YSONUnmarshaler interface {
+	UnmarshalYSON(data []byte) error
+}
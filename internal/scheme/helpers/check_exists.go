@@ -59,47 +59,60 @@ func IsDirectoryExists(ctx context.Context, c schemeClient, directory string) (
 
 func IsEntryExists(ctx context.Context, c schemeClient, absPath string, entryTypes ...scheme.EntryType) (
 	exists bool, _ error,
+) {
+	childrenType, exists, err := EntryType(ctx, c, absPath)
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+	if !exists {
+		return false, nil
+	}
+	for _, entryType := range entryTypes {
+		if childrenType == entryType {
+			return true, nil
+		}
+	}
+	directory, entryName := path.Split(absPath)
+
+	return false, xerrors.WithStackTrace(fmt.Errorf(
+		"entry type of '%s' (%s) in path '%s' is not corresponds to %v",
+		entryName, childrenType, directory, entryTypes,
+	))
+}
+
+// EntryType returns type of scheme entry with absPath or false if entry not exists
+func EntryType(ctx context.Context, c schemeClient, absPath string) (
+	entryType scheme.EntryType, exists bool, _ error,
 ) {
 	if !strings.HasPrefix(absPath, c.Database()) {
-		return false, xerrors.WithStackTrace(fmt.Errorf(
+		return entryType, false, xerrors.WithStackTrace(fmt.Errorf(
 			"entry path '%s' must be inside database '%s'",
 			absPath, c.Database(),
 		))
 	} else if absPath == c.Database() {
-		return false, xerrors.WithStackTrace(fmt.Errorf(
+		return entryType, false, xerrors.WithStackTrace(fmt.Errorf(
 			"entry path '%s' cannot be equals database name '%s'",
 			absPath, c.Database(),
 		))
 	}
 	directory, entryName := path.Split(absPath)
 	if exists, err := IsDirectoryExists(ctx, c, strings.TrimRight(directory, "/")); err != nil {
-		return false, xerrors.WithStackTrace(err)
+		return entryType, false, xerrors.WithStackTrace(err)
 	} else if !exists {
-		return false, nil
+		return entryType, false, nil
 	}
 	d, err := c.ListDirectory(ctx, directory)
 	if err != nil {
-		return false, xerrors.WithStackTrace(fmt.Errorf(
+		return entryType, false, xerrors.WithStackTrace(fmt.Errorf(
 			"list directory '%s' failed: %w",
 			directory, err,
 		))
 	}
 	for i := range d.Children {
-		if d.Children[i].Name != entryName {
-			continue
+		if d.Children[i].Name == entryName {
+			return d.Children[i].Type, true, nil
 		}
-		childrenType := d.Children[i].Type
-		for _, entryType := range entryTypes {
-			if childrenType == entryType {
-				return true, nil
-			}
-		}
-
-		return false, xerrors.WithStackTrace(fmt.Errorf(
-			"entry type of '%s' (%s) in path '%s' is not corresponds to %v",
-			entryName, childrenType, directory, entryTypes,
-		))
 	}
 
-	return false, nil
+	return entryType, false, nil
 }
@@ -238,3 +238,34 @@ func TestEntryTypePrintf(t *testing.T) {
 		fmt.Sprintf("%v", []scheme.EntryType{scheme.EntryTable, scheme.EntryColumnTable}),
 	)
 }
+
+func TestEntryType(t *testing.T) {
+	c := isTableExistsSchemeClient{"/a", "/a/b/c/d"}
+	for _, tt := range []struct {
+		checkPath string
+		entryType scheme.EntryType
+		exists    bool
+	}{
+		{
+			checkPath: "/a/b/c/d",
+			entryType: scheme.EntryTable,
+			exists:    true,
+		},
+		{
+			checkPath: "/a/b/c",
+			entryType: scheme.EntryDirectory,
+			exists:    true,
+		},
+		{
+			checkPath: "/a/b/c/e",
+			exists:    false,
+		},
+	} {
+		t.Run(tt.checkPath, func(t *testing.T) {
+			entryType, exists, err := EntryType(context.Background(), c, tt.checkPath)
+			require.NoError(t, err)
+			require.Equal(t, tt.exists, exists)
+			require.Equal(t, tt.entryType, entryType)
+		})
+	}
+}
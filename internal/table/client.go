@@ -5,14 +5,21 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jonboulle/clockwork"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
@@ -29,6 +36,11 @@ type nodeChecker interface {
 	HasNode(id uint32) bool
 }
 
+// nodeLister is an optional interface of balancer which lists nodes of cluster
+type nodeLister interface {
+	NodeIDs() []uint32
+}
+
 type balancer interface {
 	grpc.ClientConnInterface
 	nodeChecker
@@ -60,6 +72,7 @@ func newClient(
 		nodeChecker: balancer,
 		build:       builder,
 		index:       make(map[*session]sessionInfo),
+		creating:    make(map[uint32]int),
 		idle:        list.New(),
 		waitQ:       list.New(),
 		limit:       config.SizeLimit(),
@@ -70,11 +83,26 @@ func newClient(
 				return &ch
 			},
 		},
-		done: make(chan struct{}),
+		done:        make(chan struct{}),
+		drain:       make(chan struct{}),
+		drainNotify: make(chan struct{}, 1),
+		createRate:  newCreateSessionRateLimiter(config.Clock(), config.CreateSessionRateLimit()),
+		deleteSem:   make(chan struct{}, config.DeleteConcurrency()),
 	}
+	if lister, ok := balancer.(nodeLister); ok && config.NodeBalancing() {
+		c.nodeLister = lister
+	}
+	c.idleThreshold.Store(int64(config.IdleThreshold()))
 	if idleThreshold := config.IdleThreshold(); idleThreshold > 0 {
 		c.wg.Add(1)
-		go c.internalPoolGC(ctx, idleThreshold)
+		go c.internalPoolGC(ctx)
+	}
+	if minIdleSessions := config.MinIdleSessions(); minIdleSessions > 0 {
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			_ = c.Warmup(ctx, minIdleSessions)
+		}()
 	}
 
 	return c
@@ -88,19 +116,28 @@ type Client struct {
 	build       sessionBuilder
 	cc          grpc.ClientConnInterface
 	nodeChecker nodeChecker
+	nodeLister  nodeLister // not nil if node balancing of new sessions is enabled
 	clock       clockwork.Clock
+	createRate  *createSessionRateLimiter
+	deleteSem   chan struct{} // bounds concurrency of background deletions
 
 	// read-write fields
 	mu                xsync.Mutex
 	index             map[*session]sessionInfo
-	createInProgress  int        // KIKIMR-9163: in-create-process counter
-	limit             int        // Upper bound for Client size.
-	idle              *list.List // list<*session>
-	waitQ             *list.List // list<*chan *session>
+	createInProgress  int            // KIKIMR-9163: in-create-process counter
+	creating          map[uint32]int // number of sessions in create process by preferred node ID
+	limit             int            // Upper bound for Client size.
+	idle              *list.List     // list<*session>
+	waitQ             *list.List     // list<*chan *session>
 	waitChPool        sync.Pool
 	testHookGetWaitCh func() // nil except some tests.
 	wg                sync.WaitGroup
 	done              chan struct{}
+	drain             chan struct{} // closed on Drain
+	drainNotify       chan struct{} // signals about returned or removed sessions
+	createErrors      uint64
+	pendingDeletes    int          // number of sessions scheduled for background deletion
+	idleThreshold     atomic.Int64 // time.Duration
 }
 
 type createSessionOptions struct {
@@ -280,6 +317,23 @@ func (c *Client) CreateSession(ctx context.Context, opts ...table.Option) (_ tab
 	return s, xerrors.WithStackTrace(err)
 }
 
+func (c *Client) isDraining() bool {
+	select {
+	case <-c.drain:
+		return true
+	default:
+		return false
+	}
+}
+
+// c.mu must be held.
+func (c *Client) internalPoolNotifyDrain() {
+	select {
+	case c.drainNotify <- struct{}{}:
+	default:
+	}
+}
+
 func (c *Client) isClosed() bool {
 	select {
 	case <-c.done:
@@ -313,17 +367,37 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 		})
 	}()
 
-	s, err = c.createSession(
-		meta.WithAllowFeatures(ctx,
-			metaHeaders.HintSessionBalancer,
-		),
+	if err = c.createRate.wait(ctx); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if _, hasPreferredNode := balancerContext.ContextEndpoint(ctx); !hasPreferredNode {
+		if nodeID, ok := c.internalPoolReserveNode(); ok {
+			ctx = balancerContext.WithNodeID(ctx, nodeID)
+			defer c.mu.WithLock(func() {
+				c.creating[nodeID]--
+				if c.creating[nodeID] == 0 {
+					delete(c.creating, nodeID)
+				}
+			})
+		} else {
+			// server-side balancing may move session from preferred node
+			ctx = meta.WithAllowFeatures(ctx,
+				metaHeaders.HintSessionBalancer,
+			)
+		}
+	}
+
+	s, err = c.createSession(ctx,
 		withCreateSessionOnCreate(func(s *session) {
 			c.mu.WithLock(func() {
+				now := c.clock.Now()
 				c.index[s] = sessionInfo{
-					touched: c.clock.Now(),
+					touched: now,
+					created: now,
 				}
 				trace.TableOnPoolSessionAdd(c.config.Trace(), s)
-				trace.TableOnPoolStateChange(c.config.Trace(), len(c.index), "append")
+				c.internalPoolStateChange("append")
 			})
 		}), withCreateSessionOnClose(func(s *session) {
 			c.mu.WithLock(func() {
@@ -335,7 +409,6 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 				delete(c.index, s)
 
 				trace.TableOnPoolSessionRemove(c.config.Trace(), s)
-				trace.TableOnPoolStateChange(c.config.Trace(), len(c.index), "remove")
 
 				if !c.isClosed() {
 					c.internalPoolNotify(nil)
@@ -344,15 +417,56 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 				if info.idle != nil {
 					c.idle.Remove(info.idle)
 				}
+
+				c.internalPoolStateChange("remove")
+				c.internalPoolNotifyDrain()
 			})
 		}))
 	if err != nil {
+		c.mu.WithLock(func() {
+			c.createErrors++
+		})
+
 		return nil, xerrors.WithStackTrace(err)
 	}
 
 	return s, nil
 }
 
+// internalPoolReserveNode returns node with the least number of pooled and creating sessions
+// (ties are resolved in favor of smaller node ID) and counts session creation on it.
+// It returns false if node balancing is disabled or nodes are unknown.
+func (c *Client) internalPoolReserveNode() (nodeID uint32, ok bool) {
+	if c.nodeLister == nil {
+		return 0, false
+	}
+	nodeIDs := c.nodeLister.NodeIDs()
+	if len(nodeIDs) == 0 {
+		return 0, false
+	}
+
+	c.mu.WithLock(func() {
+		counts := make(map[uint32]int, len(nodeIDs))
+		for _, id := range nodeIDs {
+			counts[id] = c.creating[id]
+		}
+		for s := range c.index {
+			if _, has := counts[s.NodeID()]; has {
+				counts[s.NodeID()]++
+			}
+		}
+		minCount := -1
+		for _, id := range nodeIDs {
+			if n := counts[id]; minCount < 0 || n < minCount || n == minCount && id < nodeID {
+				nodeID, minCount = id, n
+			}
+		}
+		c.creating[nodeID]++
+	})
+
+	return nodeID, true
+}
+
 type getOptions struct {
 	t *trace.Table
 }
@@ -370,6 +484,10 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 		return nil, xerrors.WithStackTrace(errClosedClient)
 	}
 
+	if c.isDraining() {
+		return nil, xerrors.WithStackTrace(errDrainingClient)
+	}
+
 	var (
 		start = time.Now()
 		i     = 0
@@ -389,11 +507,19 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 	}()
 
 	const maxAttempts = 100
-	for s == nil && err == nil && i < maxAttempts && !c.isClosed() {
+	for s == nil && err == nil && i < maxAttempts && !c.isClosed() && !c.isDraining() {
 		i++
 		// First, we try to internalPoolGet session from idle
 		c.mu.WithLock(func() {
-			s = c.internalPoolRemoveFirstIdle()
+			if e, hasPreferredNode := balancerContext.ContextEndpoint(ctx); hasPreferredNode {
+				s = c.internalPoolRemoveIdleOnNode(e.NodeID())
+			}
+			if s == nil {
+				s = c.internalPoolRemoveFirstIdle()
+			}
+			if s != nil {
+				c.internalPoolStateChange("get")
+			}
 		})
 
 		if s != nil {
@@ -432,9 +558,12 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 		}
 	}
 	if s == nil && err == nil {
-		if c.isClosed() {
+		switch {
+		case c.isClosed():
 			err = xerrors.WithStackTrace(errClosedClient)
-		} else {
+		case c.isDraining():
+			err = xerrors.WithStackTrace(errDrainingClient)
+		default:
 			err = xerrors.WithStackTrace(errNoProgress)
 		}
 	}
@@ -469,16 +598,27 @@ func (c *Client) Get(ctx context.Context) (s *session, err error) {
 
 func (c *Client) internalPoolWaitFromCh(ctx context.Context, t *trace.Table) (s *session, err error) {
 	var (
-		ch *chan *session
-		el *list.Element // Element in the wait queue.
-		ok bool
+		ch       *chan *session
+		el       *list.Element // Element in the wait queue.
+		ok       bool
+		overflow bool
 	)
 
 	c.mu.WithLock(func() {
+		if limit := c.config.WaitQueueLimit(); limit > 0 && c.waitQ.Len() >= limit {
+			overflow = true
+
+			return
+		}
 		ch = c.internalPoolGetWaitCh()
 		el = c.waitQ.PushBack(ch)
+		c.internalPoolStateChange("wait")
 	})
 
+	if overflow {
+		return nil, xerrors.WithStackTrace(table.ErrSessionPoolQueueOverflow)
+	}
+
 	waitDone := trace.TableOnPoolWait(t, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*Client).internalPoolWaitFromCh"),
 	)
@@ -499,6 +639,7 @@ func (c *Client) internalPoolWaitFromCh(ctx context.Context, t *trace.Table) (s
 	case <-c.done:
 		c.mu.WithLock(func() {
 			c.waitQ.Remove(el)
+			c.internalPoolStateChange("wait_cancel")
 		})
 
 		return nil, xerrors.WithStackTrace(errClosedClient)
@@ -522,6 +663,7 @@ func (c *Client) internalPoolWaitFromCh(ctx context.Context, t *trace.Table) (s
 	case <-createSessionTimeoutCh:
 		c.mu.WithLock(func() {
 			c.waitQ.Remove(el)
+			c.internalPoolStateChange("wait_cancel")
 		})
 
 		return nil, nil //nolint:nilnil
@@ -529,6 +671,7 @@ func (c *Client) internalPoolWaitFromCh(ctx context.Context, t *trace.Table) (s
 	case <-ctx.Done():
 		c.mu.WithLock(func() {
 			c.waitQ.Remove(el)
+			c.internalPoolStateChange("wait_cancel")
 		})
 
 		return nil, xerrors.WithStackTrace(ctx.Err())
@@ -555,7 +698,7 @@ func (c *Client) Put(ctx context.Context, s *session) (err error) {
 
 	defer func() {
 		if err != nil {
-			c.internalPoolSyncCloseSession(ctx, s)
+			c.internalPoolAsyncCloseSession(ctx, s)
 		}
 	}()
 
@@ -572,22 +715,175 @@ func (c *Client) Put(ctx context.Context, s *session) (err error) {
 	case c.nodeChecker != nil && !c.nodeChecker.HasNode(s.NodeID()):
 		return xerrors.WithStackTrace(errNodeIsNotObservable)
 
+	case c.internalPoolSessionExpired(s):
+		c.internalPoolAsyncCloseSession(ctx, s)
+
+		return nil
+
 	default:
 		c.mu.Lock()
 		defer c.mu.Unlock()
 
-		if c.idle.Len() >= c.limit {
+		if c.idle.Len() >= c.limit || len(c.index) > c.limit {
 			return xerrors.WithStackTrace(errSessionPoolOverflow)
 		}
 
 		if !c.internalPoolNotify(s) {
 			c.internalPoolPushIdle(s, c.clock.Now())
 		}
+		c.internalPoolStateChange("put")
+		c.internalPoolNotifyDrain()
 
 		return nil
 	}
 }
 
+// SetSizeLimit changes upper bound of Client size.
+// If Client has more sessions than new limit, excess idle sessions are closed,
+// sessions in use are closed on return into Client.
+// Non-positive sizeLimit is ignored.
+func (c *Client) SetSizeLimit(sizeLimit int) {
+	if sizeLimit <= 0 {
+		return
+	}
+	c.mu.WithLock(func() {
+		if c.isClosed() {
+			return
+		}
+		c.limit = sizeLimit
+		for excess := len(c.index) - c.limit; excess > 0; excess-- {
+			s := c.internalPoolRemoveFirstIdle()
+			if s == nil {
+				break
+			}
+			s.SetStatus(table.SessionClosing)
+			c.wg.Add(1)
+			go func() {
+				defer c.wg.Done()
+				c.internalPoolSyncCloseSession(context.Background(), s)
+			}()
+		}
+		c.internalPoolStateChange("resize")
+	})
+}
+
+// SetIdleThreshold changes maximum duration of session idleness in Client.
+// It has no effect if idle sessions closing was disabled on Client creation.
+// Non-positive idleThreshold is ignored.
+func (c *Client) SetIdleThreshold(idleThreshold time.Duration) {
+	if idleThreshold <= 0 {
+		return
+	}
+	c.idleThreshold.Store(int64(idleThreshold))
+}
+
+// Stats returns snapshot of Client state
+func (c *Client) Stats() *stats.Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nodes := make(map[uint32]int)
+	for s := range c.index {
+		nodes[s.NodeID()]++
+	}
+
+	return &stats.Stats{
+		Limit:            c.limit,
+		Index:            len(c.index),
+		Idle:             c.idle.Len(),
+		InUse:            len(c.index) - c.idle.Len(),
+		WaitQ:            c.waitQ.Len(),
+		CreateInProgress: c.createInProgress,
+		CreateErrors:     c.createErrors,
+		PendingDeletes:   c.pendingDeletes,
+		Nodes:            nodes,
+	}
+}
+
+// Warmup creates sessions until Client has at least n idle sessions (but no more than
+// Client size limit) and puts them into Client.
+// It returns first error occurred during sessions creation.
+func (c *Client) Warmup(ctx context.Context, n int) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	if c.isClosed() {
+		return xerrors.WithStackTrace(errClosedClient)
+	}
+
+	if c.isDraining() {
+		return xerrors.WithStackTrace(errDrainingClient)
+	}
+
+	var toCreate int
+	c.mu.WithLock(func() {
+		toCreate = n - c.idle.Len() - c.createInProgress
+		if free := c.limit - len(c.index) - c.createInProgress; toCreate > free {
+			toCreate = free
+		}
+	})
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < toCreate; i++ {
+		g.Go(func() error {
+			s, err := c.internalPoolCreateSession(ctx)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			return c.Put(ctx, s)
+		})
+	}
+
+	return g.Wait()
+}
+
+// Drain stops giving out sessions from Client, waits for all sessions in use to be returned
+// into Client and closes Client.
+// If ctx is done before all sessions in use are returned, Drain closes Client anyway
+// and returns ctx error. Sessions in use are closed on return into closed Client.
+func (c *Client) Drain(ctx context.Context) (err error) {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	if c.isClosed() {
+		return xerrors.WithStackTrace(errClosedClient)
+	}
+
+	c.mu.WithLock(func() {
+		if c.isDraining() {
+			return
+		}
+		close(c.drain)
+		for c.waitQ.Len() > 0 {
+			c.internalPoolNotify(nil)
+		}
+	})
+
+	defer func() {
+		if closeErr := c.Close(xcontext.ValueOnly(ctx)); closeErr != nil && err == nil {
+			err = xerrors.WithStackTrace(closeErr)
+		}
+	}()
+
+	for {
+		var inUse int
+		c.mu.WithLock(func() {
+			inUse = len(c.index) - c.idle.Len()
+		})
+		if inUse == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-c.drainNotify:
+		}
+	}
+}
+
 // Close deletes all stored sessions inside Client.
 // It also stops all underlying timers and goroutines.
 // It returns first error occurred during stale sessions' deletion.
@@ -666,7 +962,14 @@ func (c *Client) Do(ctx context.Context, op table.Operation, opts ...table.Optio
 		onDone(attempts, finalErr)
 	}()
 
-	err := do(ctx, c, c.config, op, func(err error) {
+	p, release := sessionProvider(ctx, c, config.ReuseSession)
+	defer release()
+
+	if config.InferIdempotency {
+		op = inferIdempotency(op)
+	}
+
+	err := do(ctx, p, c.config, op, func(err error) {
 		attempts++
 	}, config.RetryOptions...)
 	if err != nil {
@@ -695,46 +998,99 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 		onDone(attempts, finalErr)
 	}()
 
-	return retryBackoff(ctx, c,
-		func(ctx context.Context, s table.Session) (err error) {
-			attempts++
+	p, release := sessionProvider(ctx, c, config.ReuseSession)
+	defer release()
 
-			tx, err := s.BeginTransaction(ctx, config.TxSettings)
+	txOp := func(ctx context.Context, s table.Session) (err error) {
+		attempts++
+
+		tx, err := s.BeginTransaction(ctx, config.TxSettings)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		defer func() {
 			if err != nil {
-				return xerrors.WithStackTrace(err)
+				errRollback := tx.Rollback(ctx)
+				if errRollback != nil {
+					err = xerrors.NewWithIssues("",
+						xerrors.WithStackTrace(err),
+						xerrors.WithStackTrace(errRollback),
+					)
+				} else {
+					err = xerrors.WithStackTrace(err)
+				}
 			}
+		}()
 
-			defer func() {
-				if err != nil {
-					errRollback := tx.Rollback(ctx)
-					if errRollback != nil {
-						err = xerrors.NewWithIssues("",
-							xerrors.WithStackTrace(err),
-							xerrors.WithStackTrace(errRollback),
-						)
-					} else {
-						err = xerrors.WithStackTrace(err)
+		err = func() error {
+			if panicCallback := c.config.PanicCallback(); panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						panicCallback(e)
 					}
-				}
-			}()
+				}()
+			}
 
-			err = func() error {
-				if panicCallback := c.config.PanicCallback(); panicCallback != nil {
-					defer func() {
-						if e := recover(); e != nil {
-							panicCallback(e)
-						}
-					}()
-				}
+			return op(xcontext.MarkRetryCall(ctx), tx)
+		}()
 
-				return op(xcontext.MarkRetryCall(ctx), tx)
-			}()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
 
-			if err != nil {
-				return xerrors.WithStackTrace(err)
-			}
+		_, err = tx.CommitTx(ctx, config.TxCommitOptions...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
 
-			_, err = tx.CommitTx(ctx, config.TxCommitOptions...)
+		return nil
+	}
+	if config.InferIdempotency {
+		txOp = inferIdempotency(txOp)
+	}
+
+	return retryBackoff(ctx, p, txOp, config.RetryOptions...)
+}
+
+// BulkUpsert upserts a batch of rows non-transactionally.
+//
+// BulkUpsert does not require a session and retries with idempotent flag because upsert is idempotent.
+func (c *Client) BulkUpsert(
+	ctx context.Context,
+	tableName string,
+	data table.BulkUpsertData,
+	opts ...table.Option,
+) (finalErr error) {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	if c.isClosed() {
+		return xerrors.WithStackTrace(errClosedClient)
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	request, err := data.ToYDB(a, tableName)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	client := Ydb_Table_V1.NewTableServiceClient(c.cc)
+
+	config := c.retryOptions(opts...)
+	config.RetryOptions = append(config.RetryOptions, retry.WithIdempotent(true))
+
+	err = retry.Retry(ctx,
+		func(ctx context.Context) (err error) {
+			request.OperationParams = operation.Params(ctx,
+				c.config.OperationTimeout(),
+				c.config.OperationCancelAfter(),
+				operation.ModeSync,
+			)
+			_, err = client.BulkUpsert(ctx, request)
 			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
@@ -743,6 +1099,11 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 		},
 		config.RetryOptions...,
 	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
 }
 
 func (c *Client) internalPoolGCTick(ctx context.Context, idleThreshold time.Duration) {
@@ -774,9 +1135,11 @@ func (c *Client) internalPoolGCTick(ctx context.Context, idleThreshold time.Dura
 	})
 }
 
-func (c *Client) internalPoolGC(ctx context.Context, idleThreshold time.Duration) {
+func (c *Client) internalPoolGC(ctx context.Context) {
 	defer c.wg.Done()
 
+	idleThreshold := time.Duration(c.idleThreshold.Load())
+
 	timer := c.clock.NewTimer(idleThreshold)
 	defer timer.Stop()
 
@@ -789,7 +1152,11 @@ func (c *Client) internalPoolGC(ctx context.Context, idleThreshold time.Duration
 			return
 
 		case <-timer.Chan():
+			idleThreshold = time.Duration(c.idleThreshold.Load())
 			c.internalPoolGCTick(ctx, idleThreshold)
+			if minIdleSessions := c.config.MinIdleSessions(); minIdleSessions > 0 {
+				_ = c.Warmup(ctx, minIdleSessions)
+			}
 			timer.Reset(idleThreshold / 2) //nolint:gomnd
 		}
 	}
@@ -853,6 +1220,24 @@ func (c *Client) internalPoolRemoveFirstIdle() *session {
 	return s
 }
 
+// internalPoolRemoveIdleOnNode removes first idle session on node from idle
+// c.mu must be held.
+func (c *Client) internalPoolRemoveIdleOnNode(nodeID uint32) *session {
+	for el := c.idle.Front(); el != nil; el = el.Next() {
+		s, ok := el.Value.(*session)
+		if !ok {
+			panic(fmt.Sprintf("unsupported type conversion from %T to *session", s))
+		}
+		if s.NodeID() == nodeID {
+			c.index[s] = c.internalPoolRemoveIdle(s)
+
+			return s
+		}
+	}
+
+	return nil
+}
+
 // c.mu must be held.
 func (c *Client) internalPoolNotify(s *session) (notified bool) {
 	for el := c.waitQ.Front(); el != nil; el = c.waitQ.Front() {
@@ -899,6 +1284,66 @@ func (c *Client) internalPoolSyncCloseSession(ctx context.Context, s *session) {
 	_ = s.Close(ctx)
 }
 
+// internalPoolAsyncCloseSession deletes session in background without blocking of caller.
+// Number of concurrent deletions is bounded by config.DeleteConcurrency.
+// If Client is already closed session is deleted synchronously.
+func (c *Client) internalPoolAsyncCloseSession(ctx context.Context, s *session) {
+	// closed check and wg.Add are made under c.mu (same as close(c.done) in Close)
+	// so wg.Add never runs concurrently with wg.Wait in Close
+	var closed bool
+	c.mu.WithLock(func() {
+		if closed = c.isClosed(); closed {
+			return
+		}
+		c.pendingDeletes++
+		c.wg.Add(1)
+	})
+	if closed {
+		c.internalPoolSyncCloseSession(ctx, s)
+
+		return
+	}
+
+	go func() {
+		defer c.wg.Done()
+		defer c.mu.WithLock(func() {
+			c.pendingDeletes--
+		})
+
+		c.deleteSem <- struct{}{}
+		defer func() {
+			<-c.deleteSem
+		}()
+
+		c.internalPoolSyncCloseSession(xcontext.ValueOnly(ctx), s)
+	}()
+}
+
+// internalPoolStateChange reports current state of pool with event which caused change
+// c.mu must be held.
+func (c *Client) internalPoolStateChange(event string) {
+	trace.TableOnPoolStateChange(c.config.Trace(),
+		len(c.index), c.limit, c.idle.Len(), len(c.index)-c.idle.Len(), c.waitQ.Len(), c.createInProgress,
+		event,
+	)
+}
+
+// internalPoolSessionExpired checks session age exceeds max session age
+// c.mu must NOT be held.
+func (c *Client) internalPoolSessionExpired(s *session) (expired bool) {
+	maxSessionAge := c.config.MaxSessionAge()
+	if maxSessionAge <= 0 {
+		return false
+	}
+	c.mu.WithLock(func() {
+		if info, has := c.index[s]; has {
+			expired = c.clock.Since(info.created) > maxSessionAge
+		}
+	})
+
+	return expired
+}
+
 // c.mu must be held.
 func (c *Client) internalPoolRemoveIdle(s *session) sessionInfo {
 	info, has := c.index[s]
@@ -936,4 +1381,5 @@ func (c *Client) internalPoolHandlePushIdle(s *session, now time.Time, el *list.
 type sessionInfo struct {
 	idle    *list.Element
 	touched time.Time
+	created time.Time
 }
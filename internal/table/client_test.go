@@ -14,13 +14,17 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
@@ -674,6 +678,455 @@ func TestSessionPoolGetPut(t *testing.T) {
 	assertCreated(2)
 }
 
+func TestSessionPoolStateChange(t *testing.T) {
+	var states []trace.TablePoolStateChangeInfo
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(2),
+		config.WithTrace(&trace.Table{
+			OnPoolStateChange: func(info trace.TablePoolStateChangeInfo) {
+				states = append(states, info)
+			},
+		}),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s := mustGetSession(t, p)
+	mustPutSession(t, p, s)
+	s = mustGetSession(t, p)
+	_ = s.Close(context.Background())
+
+	require.Equal(t, []trace.TablePoolStateChangeInfo{
+		{Size: 1, Limit: 2, Idle: 0, InUse: 1, CreateInProgress: 1, Event: "append"},
+		{Size: 1, Limit: 2, Idle: 1, InUse: 0, Event: "put"},
+		{Size: 1, Limit: 2, Idle: 0, InUse: 1, Event: "get"},
+		{Size: 0, Limit: 2, Idle: 0, InUse: 0, Event: "remove"},
+	}, states)
+}
+
+func TestSessionPoolWarmup(t *testing.T) {
+	newBalancer := func() balancer {
+		return testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		)
+	}
+	idle := func(p *Client) (n int) {
+		p.mu.WithLock(func() {
+			n = p.idle.Len()
+		})
+
+		return n
+	}
+	t.Run("Explicit", func(t *testing.T) {
+		p := newClientWithStubBuilder(t, newBalancer(), 0, config.WithSizeLimit(3))
+		defer func() {
+			_ = p.Close(context.Background())
+		}()
+
+		require.NoError(t, p.Warmup(context.Background(), 2))
+		require.Equal(t, 2, idle(p))
+
+		s := mustGetSession(t, p)
+		require.Equal(t, 1, idle(p))
+
+		require.NoError(t, p.Warmup(context.Background(), 5))
+		require.Equal(t, 2, idle(p))
+		require.Len(t, p.index, 3)
+
+		mustPutSession(t, p, s)
+		require.Equal(t, 3, idle(p))
+	})
+	t.Run("MinIdleSessions", func(t *testing.T) {
+		p := newClientWithStubBuilder(t, newBalancer(), 0,
+			config.WithSizeLimit(3),
+			config.WithMinIdleSessions(2),
+		)
+		defer func() {
+			_ = p.Close(context.Background())
+		}()
+
+		require.Eventually(t, func() bool {
+			return idle(p) == 2
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestSessionPoolDrain(t *testing.T) {
+	newClient := func(t *testing.T) *Client {
+		return newClientWithStubBuilder(t,
+			testutil.NewBalancer(
+				testutil.WithInvokeHandlers(
+					testutil.InvokeHandlers{
+						testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+							return &Ydb_Table.CreateSessionResult{
+								SessionId: testutil.SessionID(),
+							}, nil
+						},
+						testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+							return nil, nil
+						},
+					},
+				),
+			),
+			0,
+			config.WithSizeLimit(2),
+		)
+	}
+	t.Run("WaitInUse", func(t *testing.T) {
+		p := newClient(t)
+		idle := mustGetSession(t, p)
+		inUse := mustGetSession(t, p)
+		mustPutSession(t, p, idle)
+
+		drained := make(chan error, 1)
+		go func() {
+			drained <- p.Drain(context.Background())
+		}()
+
+		require.Eventually(t, p.isDraining, time.Second, time.Millisecond)
+		_, err := p.Get(context.Background())
+		require.ErrorIs(t, err, errDrainingClient)
+
+		select {
+		case <-drained:
+			t.Fatal("drain done with session in use")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		mustPutSession(t, p, inUse)
+		require.NoError(t, <-drained)
+		require.True(t, p.isClosed())
+		require.True(t, idle.isClosed())
+		require.True(t, inUse.isClosed())
+	})
+	t.Run("Deadline", func(t *testing.T) {
+		p := newClient(t)
+		inUse := mustGetSession(t, p)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, p.Drain(ctx), context.DeadlineExceeded)
+		require.True(t, p.isClosed())
+		require.ErrorIs(t, p.Put(context.Background(), inUse), errClosedClient)
+		require.True(t, inUse.isClosed())
+	})
+}
+
+func TestSessionPoolWaitQueueLimit(t *testing.T) {
+	p := newClientWithStubBuilder(t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(1),
+		config.WithWaitQueueLimit(1),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s := mustGetSession(t, p)
+
+	waitCh := whenWantWaitCh(p)
+	got := make(chan *session, 1)
+	go func() {
+		got <- mustGetSession(t, p)
+	}()
+	<-waitCh
+
+	_, err := p.Get(context.Background())
+	require.ErrorIs(t, err, table.ErrSessionPoolQueueOverflow)
+
+	mustPutSession(t, p, s)
+	require.Equal(t, s, <-got)
+}
+
+func TestSessionPoolMaxSessionAge(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	p := newClientWithStubBuilder(t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(1),
+		config.WithMaxSessionAge(time.Minute),
+		config.WithIdleThreshold(-1),
+		config.WithClock(clock),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s1 := mustGetSession(t, p)
+	mustPutSession(t, p, s1)
+	require.Equal(t, 1, p.idle.Len())
+
+	s2 := mustGetSession(t, p)
+	require.Equal(t, s1, s2)
+
+	clock.Advance(2 * time.Minute)
+	mustPutSession(t, p, s2)
+	require.Eventually(t, s2.isClosed, time.Second, time.Millisecond)
+	require.Equal(t, 0, p.idle.Len())
+
+	s3 := mustGetSession(t, p)
+	require.NotSame(t, s1, s3)
+}
+
+func TestSessionPoolStats(t *testing.T) {
+	var createErr error
+	p := newClientWithStubBuilder(t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						if createErr != nil {
+							return nil, createErr
+						}
+
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(testutil.WithNodeID(1)),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(3),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s1 := mustGetSession(t, p)
+	s2 := mustGetSession(t, p)
+	mustPutSession(t, p, s1)
+	require.Equal(t, &stats.Stats{
+		Limit: 3, Index: 2, Idle: 1, InUse: 1,
+		Nodes: map[uint32]int{1: 2},
+	}, p.Stats())
+
+	mustGetSession(t, p)
+	createErr = errors.New("create session error")
+	_, err := p.Get(context.Background())
+	require.Error(t, err)
+	mustPutSession(t, p, s2)
+	require.Equal(t, &stats.Stats{
+		Limit: 3, Index: 2, Idle: 1, InUse: 1, CreateErrors: 1,
+		Nodes: map[uint32]int{1: 2},
+	}, p.Stats())
+}
+
+type nodeListerBalancer struct {
+	balancer
+	nodeIDs []uint32
+}
+
+func (b *nodeListerBalancer) NodeIDs() []uint32 {
+	return b.nodeIDs
+}
+
+func TestSessionPoolNodeBalancing(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newClient(ctx,
+		&nodeListerBalancer{
+			balancer: testutil.NewBalancer(),
+			nodeIDs:  []uint32{1, 2, 3},
+		},
+		func(ctx context.Context) (*session, error) {
+			endpoint, ok := balancerContext.ContextEndpoint(ctx)
+			if !ok {
+				return nil, errors.New("no preferred node")
+			}
+
+			return &session{
+				id:     testutil.SessionID(testutil.WithNodeID(endpoint.NodeID())),
+				config: config.New(),
+			}, nil
+		},
+		config.New(config.WithSizeLimit(10), config.WithNodeBalancing()),
+	)
+	defer func() {
+		_ = c.Close(context.Background())
+	}()
+
+	for i := 0; i < 3; i++ {
+		_, err := c.internalPoolCreateSession(balancerContext.WithNodeID(ctx, 1))
+		require.NoError(t, err)
+	}
+	var nodeIDs []uint32
+	for i := 0; i < 4; i++ {
+		s, err := c.internalPoolCreateSession(ctx)
+		require.NoError(t, err)
+		nodeIDs = append(nodeIDs, s.NodeID())
+	}
+	require.Equal(t, []uint32{2, 3, 2, 3}, nodeIDs)
+	require.Equal(t, map[uint32]int{1: 3, 2: 2, 3: 2}, c.Stats().Nodes)
+	require.Empty(t, c.creating)
+}
+
+func TestSessionPoolBackgroundDelete(t *testing.T) {
+	var (
+		deleteStarted = make(chan struct{}, 2)
+		deleteRelease = make(chan struct{})
+	)
+	p := newClientWithStubBuilder(t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						deleteStarted <- struct{}{}
+						<-deleteRelease
+
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(2),
+		config.WithDeleteConcurrency(1),
+		config.WithDeleteTimeout(time.Minute),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s1 := mustGetSession(t, p)
+	s2 := mustGetSession(t, p)
+	s1.SetStatus(table.SessionClosing)
+	s2.SetStatus(table.SessionClosing)
+	require.Error(t, p.Put(context.Background(), s1))
+	require.Error(t, p.Put(context.Background(), s2))
+	require.Equal(t, 2, p.Stats().PendingDeletes)
+
+	<-deleteStarted
+	select {
+	case <-deleteStarted:
+		t.Fatal("concurrency of background deletions is not bounded")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(deleteRelease)
+	require.Eventually(t, func() bool {
+		return p.Stats().PendingDeletes == 0
+	}, time.Second, time.Millisecond)
+	require.Equal(t, 0, p.Stats().Index)
+}
+
+func TestSessionPoolSetSizeLimit(t *testing.T) {
+	p := newClientWithStubBuilder(t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(testutil.WithNodeID(1)),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(4),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s1 := mustGetSession(t, p)
+	s2 := mustGetSession(t, p)
+	s3 := mustGetSession(t, p)
+	s4 := mustGetSession(t, p)
+	mustPutSession(t, p, s1)
+	mustPutSession(t, p, s2)
+
+	p.SetSizeLimit(1)
+	require.Eventually(t, func() bool {
+		return p.Stats().Index == 2
+	}, time.Second, time.Millisecond)
+	require.True(t, s1.isClosed())
+	require.True(t, s2.isClosed())
+
+	require.ErrorIs(t, p.Put(context.Background(), s3), errSessionPoolOverflow)
+	require.Eventually(t, s3.isClosed, time.Second, time.Millisecond)
+	mustPutSession(t, p, s4)
+	require.Equal(t, &stats.Stats{
+		Limit: 1, Index: 1, Idle: 1,
+		Nodes: map[uint32]int{1: 1},
+	}, p.Stats())
+
+	p.SetSizeLimit(2)
+	require.Equal(t, s4, mustGetSession(t, p))
+	mustGetSession(t, p)
+	require.Equal(t, &stats.Stats{
+		Limit: 2, Index: 2, InUse: 2,
+		Nodes: map[uint32]int{1: 2},
+	}, p.Stats())
+}
+
 func TestSessionPoolCloseIdleSessions(t *testing.T) {
 	xtest.TestManyTimes(t, func(t testing.TB) {
 		var (
@@ -1036,3 +1489,239 @@ func TestDeadlockOnInternalPoolGCTick(t *testing.T) {
 		c.internalPoolGCTick(ctx, 0)
 	}, xtest.StopAfter(12*time.Second))
 }
+
+func TestClientBulkUpsert(t *testing.T) {
+	ctx := xtest.Context(t)
+	attempts := 0
+	c := newClientWithStubBuilder(t,
+		testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+			testutil.TableBulkUpsert: func(request interface{}) (proto.Message, error) {
+				attempts++
+				r, ok := request.(*Ydb_Table.BulkUpsertRequest)
+				if !ok {
+					return nil, fmt.Errorf("unexpected request type %T", request)
+				}
+				require.Equal(t, "/local/test", r.GetTable())
+				require.Len(t, r.GetRows().GetValue().GetItems(), 2)
+				if attempts == 1 {
+					return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+				}
+
+				return &Ydb_Table.BulkUpsertResult{}, nil
+			},
+		})),
+		0,
+	)
+	defer func() {
+		_ = c.Close(ctx)
+	}()
+	err := c.BulkUpsert(ctx, "/local/test", table.BulkUpsertDataRows(value.ListValue(
+		value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(1)}),
+		value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(2)}),
+	)))
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+}
+
+func TestClientDoTx(t *testing.T) {
+	ctx := xtest.Context(t)
+	for _, tt := range []struct {
+		name      string
+		opErr     error
+		commitErr []error
+		err       bool
+		attempts  int
+		commits   int
+		rollbacks int
+	}{
+		{
+			name:     "Commit",
+			attempts: 1,
+			commits:  1,
+		},
+		{
+			name:      "RollbackOnError",
+			opErr:     errors.New("op error"),
+			err:       true,
+			attempts:  1,
+			rollbacks: 1,
+		},
+		{
+			name: "RetryAbortedCommit",
+			commitErr: []error{
+				xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ABORTED)),
+			},
+			attempts:  2,
+			commits:   2,
+			rollbacks: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				attempts  int
+				commits   int
+				rollbacks int
+			)
+			c := newClientWithStubBuilder(t,
+				testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+					testutil.TableBeginTransaction: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.BeginTransactionResult{
+							TxMeta: &Ydb_Table.TransactionMeta{Id: "tx"},
+						}, nil
+					},
+					testutil.TableCommitTransaction: func(interface{}) (proto.Message, error) {
+						commits++
+						if commits <= len(tt.commitErr) {
+							return nil, tt.commitErr[commits-1]
+						}
+
+						return &Ydb_Table.CommitTransactionResult{}, nil
+					},
+					testutil.TableRollbackTransaction: func(interface{}) (proto.Message, error) {
+						rollbacks++
+
+						return nil, nil
+					},
+				})),
+				0,
+			)
+			defer func() {
+				_ = c.Close(ctx)
+			}()
+			err := c.DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+				attempts++
+				require.Equal(t, "tx", tx.ID())
+
+				return tt.opErr
+			})
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.attempts, attempts)
+			require.Equal(t, tt.commits, commits)
+			require.Equal(t, tt.rollbacks, rollbacks)
+		})
+	}
+}
+
+func TestClientDoIdempotencyInference(t *testing.T) {
+	ctx := xtest.Context(t)
+	for _, tt := range []struct {
+		name      string
+		txControl *table.TransactionControl
+		opts      []table.Option
+		attempts  int
+	}{
+		{
+			name:      "ReadOnly",
+			txControl: table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx()),
+			opts:      []table.Option{table.WithIdempotencyInference()},
+			attempts:  2,
+		},
+		{
+			name:      "ReadOnlyWithoutInference",
+			txControl: table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx()),
+			attempts:  1,
+		},
+		{
+			name:      "ReadWrite",
+			txControl: table.DefaultTxControl(),
+			opts:      []table.Option{table.WithIdempotencyInference()},
+			attempts:  1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			executes := 0
+			c := newClientWithStubBuilder(t,
+				testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+					testutil.TableExecuteDataQuery: func(interface{}) (proto.Message, error) {
+						executes++
+						if executes == 1 {
+							return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNDETERMINED))
+						}
+
+						return &Ydb_Table.ExecuteQueryResult{
+							TxMeta: &Ydb_Table.TransactionMeta{},
+						}, nil
+					},
+				})),
+				0,
+			)
+			defer func() {
+				_ = c.Close(ctx)
+			}()
+			attempts := 0
+			err := c.Do(ctx, func(ctx context.Context, s table.Session) error {
+				attempts++
+				_, res, err := s.Execute(ctx, tt.txControl, "SELECT 1", nil)
+				if err != nil {
+					return err
+				}
+
+				return res.Close()
+			}, tt.opts...)
+			if tt.attempts > 1 {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+			require.Equal(t, tt.attempts, attempts)
+		})
+	}
+}
+
+func TestSessionPoolGetPreferredNode(t *testing.T) {
+	var nodeID uint32
+	p := newClientWithStubBuilder(t,
+		testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+			testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+				nodeID++
+
+				return &Ydb_Table.CreateSessionResult{
+					SessionId: testutil.SessionID(testutil.WithNodeID(nodeID)),
+				}, nil
+			},
+			testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+				return nil, nil
+			},
+		})),
+		0,
+		config.WithSizeLimit(3),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s1 := mustGetSession(t, p)
+	s2 := mustGetSession(t, p)
+	require.Equal(t, uint32(1), s1.NodeID())
+	require.Equal(t, uint32(2), s2.NodeID())
+	mustPutSession(t, p, s1)
+	mustPutSession(t, p, s2)
+
+	s, err := p.Get(balancerContext.WithNodeID(context.Background(), 2))
+	require.NoError(t, err)
+	require.Equal(t, s2, s)
+
+	s, err = p.Get(balancerContext.WithNodeID(context.Background(), 3))
+	require.NoError(t, err)
+	require.Equal(t, s1, s)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/jonboulle/clockwork"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -14,6 +16,7 @@ const (
 	DefaultSessionPoolCreateSessionTimeout = 5 * time.Second
 	DefaultSessionPoolSizeLimit            = 50
 	DefaultSessionPoolIdleThreshold        = 5 * time.Minute
+	DefaultSessionPoolDeleteConcurrency    = 16
 
 	// Deprecated: table client do not supports background session keep-aliving now.
 	// Will be removed after Oct 2024.
@@ -148,6 +151,149 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithStrictNullScan enables errors on scanning of NULL values into destinations which cannot
+// hold NULL (not pointers and not sql.Scanner). By default NULL is scanned as zero value.
+func WithStrictNullScan() Option {
+	return func(c *Config) {
+		c.strictNullScan = true
+	}
+}
+
+// WithCopyBytesScan enables copying of String and Utf8 values scanned into []byte destinations.
+// By default scanned bytes share memory with result buffer, so they are valid only until
+// next row and must not be modified.
+func WithCopyBytesScan() Option {
+	return func(c *Config) {
+		c.copyBytesScan = true
+	}
+}
+
+// WithColumnMatching sets mode of matching of columns passed to NextResultSet with columns
+// of result set. By default result.ColumnMatchingLenient is used.
+func WithColumnMatching(columnMatching result.ColumnMatching) Option {
+	return func(c *Config) {
+		c.columnMatching = columnMatching
+	}
+}
+
+// WithKeepInCache enables keep-in-cache flag of query cache policy for all data queries
+//
+// Keep-in-cache flag may be disabled for single call with options.WithKeepInCache(false)
+func WithKeepInCache() Option {
+	return func(c *Config) {
+		c.keepInCache = true
+	}
+}
+
+// WithPreparedStatementsCacheSize enables per-session LRU cache of prepared statements
+// keyed by query text with given capacity.
+//
+// If size is less than or equal to zero then prepared statements are not cached.
+func WithPreparedStatementsCacheSize(size int) Option {
+	return func(c *Config) {
+		if size < 0 {
+			size = 0
+		}
+		c.preparedStatementsCacheSize = size
+	}
+}
+
+// WithMinIdleSessions defines number of sessions which table client creates at start and
+// keeps idle in the pool. Idle sessions closed by idle threshold are replaced with new ones.
+//
+// If minIdleSessions is less than or equal to zero then no sessions are pre-created.
+func WithMinIdleSessions(minIdleSessions int) Option {
+	return func(c *Config) {
+		if minIdleSessions < 0 {
+			minIdleSessions = 0
+		}
+		c.minIdleSessions = minIdleSessions
+	}
+}
+
+// WithWaitQueueLimit defines maximum number of callers waiting for session when pool is exhausted.
+// Callers above this limit receive table.ErrSessionPoolQueueOverflow error instead of waiting.
+//
+// If waitQueueLimit is less than or equal to zero then number of waiting callers is not limited.
+func WithWaitQueueLimit(waitQueueLimit int) Option {
+	return func(c *Config) {
+		if waitQueueLimit < 0 {
+			waitQueueLimit = 0
+		}
+		c.waitQueueLimit = waitQueueLimit
+	}
+}
+
+// WithMaxSessionAge defines maximum lifetime of session in the pool.
+// Sessions older than maxSessionAge are closed on return into the pool instead of reuse,
+// new sessions are created on demand (possibly on other nodes).
+//
+// If maxSessionAge is less than or equal to zero then session lifetime is not limited.
+func WithMaxSessionAge(maxSessionAge time.Duration) Option {
+	return func(c *Config) {
+		if maxSessionAge < 0 {
+			maxSessionAge = 0
+		}
+		c.maxSessionAge = maxSessionAge
+	}
+}
+
+// WithCreateSessionRateLimit limits rate of session creations in the pool by ratePerSecond.
+// Session creations above the rate are queued and spread in time.
+//
+// If ratePerSecond is less than or equal to zero then rate of session creations is not limited.
+func WithCreateSessionRateLimit(ratePerSecond float64) Option {
+	return func(c *Config) {
+		if ratePerSecond < 0 {
+			ratePerSecond = 0
+		}
+		c.createSessionRateLimit = ratePerSecond
+	}
+}
+
+// WithNodeBalancing makes the pool create new sessions on nodes with the least number of
+// pooled sessions instead of server-side balancing of new sessions.
+// It helps to avoid skew of sessions across nodes after changes of cluster topology.
+func WithNodeBalancing() Option {
+	return func(c *Config) {
+		c.nodeBalancing = true
+	}
+}
+
+// WithDeleteConcurrency limits number of broken sessions which are deleted in background concurrently.
+//
+// If deleteConcurrency is less than or equal to zero then the DefaultSessionPoolDeleteConcurrency is used.
+func WithDeleteConcurrency(deleteConcurrency int) Option {
+	return func(c *Config) {
+		if deleteConcurrency <= 0 {
+			deleteConcurrency = DefaultSessionPoolDeleteConcurrency
+		}
+		c.deleteConcurrency = deleteConcurrency
+	}
+}
+
+// WithOnSessionCreated appends callback which is called after creation of each session.
+// Unlike trace callbacks, onCreated is called only for successfully created sessions.
+func WithOnSessionCreated(onCreated func(s table.SessionInfo)) Option {
+	return func(c *Config) {
+		c.onSessionCreated = append(c.onSessionCreated, onCreated)
+	}
+}
+
+// WithOnSessionDeleted appends callback which is called after deletion of each session.
+func WithOnSessionDeleted(onDeleted func(s table.SessionInfo)) Option {
+	return func(c *Config) {
+		c.onSessionDeleted = append(c.onSessionDeleted, onDeleted)
+	}
+}
+
+// WithOnSessionStateChanged appends callback which is called on each change of session status.
+func WithOnSessionStateChanged(onStateChanged func(s table.SessionInfo, status table.SessionStatus)) Option {
+	return func(c *Config) {
+		c.onSessionStateChanged = append(c.onSessionStateChanged, onStateChanged)
+	}
+}
+
 // WithClock replaces default clock
 func WithClock(clock clockwork.Clock) Option {
 	return func(c *Config) {
@@ -159,16 +305,32 @@ func WithClock(clock clockwork.Clock) Option {
 type Config struct {
 	config.Common
 
-	sizeLimit int
+	sizeLimit         int
+	minIdleSessions   int
+	waitQueueLimit    int
+	deleteConcurrency int
 
-	createSessionTimeout time.Duration
-	deleteTimeout        time.Duration
-	idleThreshold        time.Duration
+	createSessionTimeout   time.Duration
+	createSessionRateLimit float64
+	nodeBalancing          bool
+	deleteTimeout          time.Duration
+	idleThreshold          time.Duration
+	maxSessionAge          time.Duration
 
 	ignoreTruncated bool
+	strictNullScan  bool
+	copyBytesScan   bool
+	columnMatching  result.ColumnMatching
+	keepInCache     bool
+
+	preparedStatementsCacheSize int
 
 	trace *trace.Table
 
+	onSessionCreated      []func(s table.SessionInfo)
+	onSessionDeleted      []func(s table.SessionInfo)
+	onSessionStateChanged []func(s table.SessionInfo, status table.SessionStatus)
+
 	clock clockwork.Clock
 }
 
@@ -189,6 +351,34 @@ func (c *Config) SizeLimit() int {
 	return c.sizeLimit
 }
 
+// MinIdleSessions is a number of sessions which table client creates at start and keeps idle in the pool
+func (c *Config) MinIdleSessions() int {
+	return c.minIdleSessions
+}
+
+// WaitQueueLimit is a maximum number of callers waiting for session when pool is exhausted.
+// If WaitQueueLimit is zero then number of waiting callers is not limited.
+func (c *Config) WaitQueueLimit() int {
+	return c.waitQueueLimit
+}
+
+// MaxSessionAge is a maximum lifetime of session in the pool.
+// If MaxSessionAge is zero then session lifetime is not limited.
+func (c *Config) MaxSessionAge() time.Duration {
+	return c.maxSessionAge
+}
+
+// CreateSessionRateLimit is a maximum rate of session creations per second.
+// If CreateSessionRateLimit is zero then rate of session creations is not limited.
+func (c *Config) CreateSessionRateLimit() float64 {
+	return c.createSessionRateLimit
+}
+
+// NodeBalancing reports whether the pool creates new sessions on nodes with the least number of sessions
+func (c *Config) NodeBalancing() bool {
+	return c.nodeBalancing
+}
+
 // KeepAliveMinSize is a lower bound for sessions in the pool. If there are more sessions open, then
 // the excess idle ones will be closed and removed after IdleKeepAliveThreshold is reached for each of them.
 // If KeepAliveMinSize is less than zero, then no sessions will be preserved
@@ -206,6 +396,33 @@ func (c *Config) IgnoreTruncated() bool {
 	return c.ignoreTruncated
 }
 
+// StrictNullScan specifies behavior on scanning of NULL values into destinations which cannot hold NULL
+func (c *Config) StrictNullScan() bool {
+	return c.strictNullScan
+}
+
+// CopyBytesScan specifies whether scanned []byte values are copied from result buffer
+func (c *Config) CopyBytesScan() bool {
+	return c.copyBytesScan
+}
+
+// ColumnMatching specifies mode of matching of columns passed to NextResultSet with columns of result set
+func (c *Config) ColumnMatching() result.ColumnMatching {
+	return c.columnMatching
+}
+
+// KeepInCache specifies default keep-in-cache flag of query cache policy for data queries
+func (c *Config) KeepInCache() bool {
+	return c.keepInCache
+}
+
+// PreparedStatementsCacheSize is a capacity of per-session prepared statements cache
+//
+// Zero value means that prepared statements are not cached.
+func (c *Config) PreparedStatementsCacheSize() int {
+	return c.preparedStatementsCacheSize
+}
+
 // IdleKeepAliveThreshold is a number of keepAlive messages to call before the
 // session is removed if it is an excess session (see KeepAliveMinSize)
 // This means that session will be deleted after the expiration of lifetime = IdleThreshold * IdleKeepAliveThreshold
@@ -251,11 +468,34 @@ func (c *Config) DeleteTimeout() time.Duration {
 	return c.deleteTimeout
 }
 
+// DeleteConcurrency is a maximum number of broken sessions which are deleted in background concurrently.
+//
+// If DeleteConcurrency is less than or equal to zero then the DefaultSessionPoolDeleteConcurrency is used.
+func (c *Config) DeleteConcurrency() int {
+	return c.deleteConcurrency
+}
+
+// OnSessionCreated returns callbacks which are called after creation of each session
+func (c *Config) OnSessionCreated() []func(s table.SessionInfo) {
+	return c.onSessionCreated
+}
+
+// OnSessionDeleted returns callbacks which are called after deletion of each session
+func (c *Config) OnSessionDeleted() []func(s table.SessionInfo) {
+	return c.onSessionDeleted
+}
+
+// OnSessionStateChanged returns callbacks which are called on each change of session status
+func (c *Config) OnSessionStateChanged() []func(s table.SessionInfo, status table.SessionStatus) {
+	return c.onSessionStateChanged
+}
+
 func defaults() *Config {
 	return &Config{
 		sizeLimit:            DefaultSessionPoolSizeLimit,
 		createSessionTimeout: DefaultSessionPoolCreateSessionTimeout,
 		deleteTimeout:        DefaultSessionPoolDeleteTimeout,
+		deleteConcurrency:    DefaultSessionPoolDeleteConcurrency,
 		idleThreshold:        DefaultSessionPoolIdleThreshold,
 		clock:                clockwork.NewRealClock(),
 		trace:                &trace.Table{},
@@ -0,0 +1,83 @@
+package table
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// createSessionRateLimiter spreads session creations in time with fixed interval.
+// Excess creations are queued, not rejected.
+type createSessionRateLimiter struct {
+	mu       sync.Mutex
+	clock    clockwork.Clock
+	interval time.Duration
+	next     time.Time
+}
+
+func newCreateSessionRateLimiter(clock clockwork.Clock, ratePerSecond float64) *createSessionRateLimiter {
+	if ratePerSecond <= 0 {
+		return nil
+	}
+
+	return &createSessionRateLimiter{
+		clock:    clock,
+		interval: time.Duration(float64(time.Second) / ratePerSecond),
+	}
+}
+
+// reserve returns delay before next session creation is allowed
+func (l *createSessionRateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	return delay
+}
+
+// cancel gives back slot of reservation which was not used, so
+// canceled waiters do not delay next session creations
+func (l *createSessionRateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	l.next = l.next.Add(-l.interval)
+	if l.next.Before(now) {
+		l.next = now
+	}
+}
+
+// wait blocks until session creation is allowed or ctx is done
+func (l *createSessionRateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := l.clock.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+
+		return xerrors.WithStackTrace(ctx.Err())
+	case <-timer.Chan():
+		return nil
+	}
+}
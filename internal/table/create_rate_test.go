@@ -0,0 +1,73 @@
+package table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSessionRateLimiter(t *testing.T) {
+	t.Run("Unlimited", func(t *testing.T) {
+		l := newCreateSessionRateLimiter(clockwork.NewFakeClock(), 0)
+		require.Nil(t, l)
+		require.NoError(t, l.wait(context.Background()))
+	})
+	t.Run("Reserve", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		l := newCreateSessionRateLimiter(clock, 2)
+		require.Equal(t, time.Duration(0), l.reserve())
+		require.Equal(t, 500*time.Millisecond, l.reserve())
+		require.Equal(t, time.Second, l.reserve())
+		clock.Advance(3 * time.Second)
+		require.Equal(t, time.Duration(0), l.reserve())
+	})
+	t.Run("Wait", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		l := newCreateSessionRateLimiter(clock, 1)
+		require.NoError(t, l.wait(context.Background()))
+
+		done := make(chan error, 1)
+		go func() {
+			done <- l.wait(context.Background())
+		}()
+		clock.BlockUntil(1)
+		select {
+		case <-done:
+			t.Fatal("wait done before interval elapsed")
+		default:
+		}
+		clock.Advance(time.Second)
+		require.NoError(t, <-done)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		l := newCreateSessionRateLimiter(clockwork.NewFakeClock(), 1)
+		require.NoError(t, l.wait(context.Background()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorIs(t, l.wait(ctx), context.Canceled)
+	})
+	t.Run("CanceledWaitersReleaseSlots", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		l := newCreateSessionRateLimiter(clock, 1)
+		require.NoError(t, l.wait(context.Background()))
+
+		const waiters = 3
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, waiters)
+		for i := 0; i < waiters; i++ {
+			go func() {
+				done <- l.wait(ctx)
+			}()
+		}
+		clock.BlockUntil(waiters)
+		cancel()
+		for i := 0; i < waiters; i++ {
+			require.ErrorIs(t, <-done, context.Canceled)
+		}
+		require.Equal(t, time.Second, l.reserve())
+	})
+}
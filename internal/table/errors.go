@@ -16,6 +16,10 @@ var (
 	// that Client is closed early and not able to complete requested operation.
 	errClosedClient = xerrors.Wrap(errors.New("table client closed early"))
 
+	// errDrainingClient returned by a Client instance to indicate
+	// that Client is draining and not able to give out sessions.
+	errDrainingClient = xerrors.Wrap(errors.New("table client is draining"))
+
 	// errSessionPoolOverflow returned by a Client instance to indicate
 	// that the Client is full and requested operation is not able to complete.
 	errSessionPoolOverflow = xerrors.Wrap(errors.New("session pool overflow"))
@@ -0,0 +1,102 @@
+package table
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+// writesTracker marks session as modified on calls which may change data
+type writesTracker struct {
+	grpc.ClientConnInterface
+
+	s *session
+}
+
+func (t writesTracker) Invoke(
+	ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption,
+) error {
+	if !isReadOnlyCall(method, args) {
+		t.s.writes.Store(true)
+	}
+
+	return t.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (t writesTracker) NewStream(
+	ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if !isReadOnlyCall(method, nil) {
+		t.s.writes.Store(true)
+	}
+
+	return t.ClientConnInterface.NewStream(ctx, desc, method, opts...)
+}
+
+func isReadOnlyCall(method string, args interface{}) bool {
+	switch method {
+	case
+		Ydb_Table_V1.TableService_CreateSession_FullMethodName,
+		Ydb_Table_V1.TableService_DeleteSession_FullMethodName,
+		Ydb_Table_V1.TableService_KeepAlive_FullMethodName,
+		Ydb_Table_V1.TableService_DescribeTable_FullMethodName,
+		Ydb_Table_V1.TableService_DescribeTableOptions_FullMethodName,
+		Ydb_Table_V1.TableService_ExplainDataQuery_FullMethodName,
+		Ydb_Table_V1.TableService_PrepareDataQuery_FullMethodName,
+		Ydb_Table_V1.TableService_StreamReadTable_FullMethodName,
+		Ydb_Table_V1.TableService_StreamExecuteScanQuery_FullMethodName,
+		Ydb_Table_V1.TableService_ReadRows_FullMethodName,
+		Ydb_Table_V1.TableService_CommitTransaction_FullMethodName,
+		Ydb_Table_V1.TableService_RollbackTransaction_FullMethodName:
+		return true
+	case Ydb_Table_V1.TableService_BeginTransaction_FullMethodName:
+		request, ok := args.(*Ydb_Table.BeginTransactionRequest)
+
+		return ok && isReadOnlyTxSettings(request.GetTxSettings())
+	case Ydb_Table_V1.TableService_ExecuteDataQuery_FullMethodName:
+		request, ok := args.(*Ydb_Table.ExecuteDataQueryRequest)
+		if !ok {
+			return false
+		}
+		if request.GetTxControl().GetTxId() != "" {
+			// data modifications of explicit transaction are tracked on transaction begin
+			return true
+		}
+
+		return isReadOnlyTxSettings(request.GetTxControl().GetBeginTx())
+	default:
+		return false
+	}
+}
+
+func isReadOnlyTxSettings(settings *Ydb_Table.TransactionSettings) bool {
+	return settings.GetOnlineReadOnly() != nil ||
+		settings.GetStaleReadOnly() != nil ||
+		settings.GetSnapshotReadOnly() != nil
+}
+
+// inferIdempotency wraps operation so errors of attempts which made only read-only calls
+// are retried as errors of idempotent operation
+func inferIdempotency(op table.Operation) table.Operation {
+	return func(ctx context.Context, s table.Session) error {
+		ss, ok := s.(*session)
+		if !ok {
+			return op(ctx, s)
+		}
+
+		ss.writes.Store(false)
+
+		err := op(ctx, s)
+		if err != nil && !ss.writes.Load() && retry.Check(err).MustRetry(true) {
+			return xerrors.WithStackTrace(xerrors.Retryable(err))
+		}
+
+		return err
+	}
+}
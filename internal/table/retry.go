@@ -22,6 +22,54 @@ type SessionProvider interface {
 	Put(ctx context.Context, s *session) (err error)
 }
 
+// stickySessionProvider keeps the session between retry attempts while the
+// session stays valid. It returns the session to the underlying provider on
+// release or when the session must be deleted.
+type stickySessionProvider struct {
+	p SessionProvider
+	s *session
+}
+
+func (sp *stickySessionProvider) Get(ctx context.Context) (*session, error) {
+	if s := sp.s; s != nil {
+		sp.s = nil
+		if !s.isClosing() && !s.isClosed() {
+			return s, nil
+		}
+		_ = sp.p.Put(ctx, s)
+	}
+
+	return sp.p.Get(ctx)
+}
+
+func (sp *stickySessionProvider) Put(ctx context.Context, s *session) error {
+	if !s.isClosing() && !s.isClosed() {
+		sp.s = s
+
+		return nil
+	}
+
+	return sp.p.Put(ctx, s)
+}
+
+func (sp *stickySessionProvider) release(ctx context.Context) {
+	if s := sp.s; s != nil {
+		sp.s = nil
+		_ = sp.p.Put(ctx, s)
+	}
+}
+
+func sessionProvider(ctx context.Context, p SessionProvider, reuseSession bool) (SessionProvider, func()) {
+	if !reuseSession {
+		return p, func() {}
+	}
+	sp := &stickySessionProvider{p: p}
+
+	return sp, func() {
+		sp.release(xcontext.ValueOnly(ctx))
+	}
+}
+
 func do(
 	ctx context.Context,
 	c SessionProvider,
@@ -80,7 +128,7 @@ func retryBackoff(
 			}()
 
 			if err = op(ctx, s); err != nil {
-				s.checkError(err)
+				s.checkError(err, opts...)
 
 				return xerrors.WithStackTrace(err)
 			}
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -133,6 +135,49 @@ func TestRetryerBadSession(t *testing.T) {
 	}
 }
 
+func TestRetryerPolicyDeleteSession(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		code          Ydb.StatusIds_StatusCode
+		deleteSession bool
+	}{
+		{
+			name:          "DeleteOnOverloaded",
+			code:          Ydb.StatusIds_OVERLOADED,
+			deleteSession: true,
+		},
+		{
+			name:          "KeepOnBadSession",
+			code:          Ydb.StatusIds_BAD_SESSION,
+			deleteSession: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				s        = simpleSession(t)
+				attempts int
+			)
+			err := do(context.Background(), SingleSession(s), config.New(),
+				func(ctx context.Context, _ table.Session) error {
+					attempts++
+					if attempts > 1 {
+						return nil
+					}
+
+					return xerrors.Operation(xerrors.WithStatusCode(tt.code))
+				},
+				nil,
+				retry.WithPolicies(map[Ydb.StatusIds_StatusCode]retry.Policy{
+					tt.code: {Retryable: true, DeleteSession: &tt.deleteSession},
+				}),
+			)
+			require.NoError(t, err)
+			require.Equal(t, 2, attempts)
+			require.Equal(t, tt.deleteSession, s.isClosing())
+		})
+	}
+}
+
 func TestRetryerSessionClosing(t *testing.T) {
 	closed := make(map[table.Session]bool)
 	p := SessionProviderFunc{
@@ -484,6 +529,70 @@ func TestRetryWithCustomErrors(t *testing.T) {
 	}
 }
 
+func TestRetryerSessionReuse(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		reuseSession bool
+		err          error
+		gets         int
+	}{
+		{
+			name:         "FreshSessionPerAttempt",
+			reuseSession: false,
+			err:          xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE)),
+			gets:         3,
+		},
+		{
+			name:         "ReuseSession",
+			reuseSession: true,
+			err:          xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE)),
+			gets:         1,
+		},
+		{
+			name:         "ReuseSessionAfterBadSession",
+			reuseSession: true,
+			err:          xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION)),
+			gets:         3,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var gets, puts int
+			p := SessionProviderFunc{
+				OnGet: func(ctx context.Context) (*session, error) {
+					gets++
+
+					return simpleSession(t), nil
+				},
+				OnPut: func(ctx context.Context, s *session) error {
+					puts++
+
+					return nil
+				},
+			}
+			sp, release := sessionProvider(context.Background(), p, tt.reuseSession)
+			attempts := 0
+			err := do(context.Background(), sp, config.New(),
+				func(ctx context.Context, s table.Session) error {
+					attempts++
+					if attempts < 3 {
+						return tt.err
+					}
+
+					return nil
+				},
+				nil,
+				retry.WithIdempotent(true),
+				retry.WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Millisecond))),
+			)
+			require.NoError(t, err)
+			release()
+			require.Equal(t, 3, attempts)
+			require.Equal(t, tt.gets, gets)
+			require.Equal(t, gets, puts)
+		})
+	}
+}
+
 type SessionProviderFunc struct {
 	OnGet func(context.Context) (*session, error)
 	OnPut func(context.Context, *session) error
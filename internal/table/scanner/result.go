@@ -8,9 +8,11 @@ import (
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
@@ -74,6 +76,25 @@ func (r *unaryResult) ResultSetCount() int {
 	return len(r.sets)
 }
 
+func (r *unaryResult) RowCounts() []int {
+	counts := make([]int, len(r.sets))
+	for i, set := range r.sets {
+		counts[i] = len(set.GetRows())
+	}
+
+	return counts
+}
+
+func (r *unaryResult) HasTruncatedResultSet() bool {
+	for _, set := range r.sets {
+		if set.GetTruncated() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *baseResult) isClosed() bool {
 	return r.closed.Load()
 }
@@ -106,6 +127,28 @@ func WithMarkTruncatedAsRetryable() option {
 	}
 }
 
+// WithStrictNull enables errors on scanning of NULL values into destinations which cannot hold NULL
+func WithStrictNull(strictNull bool) option {
+	return func(r *baseResult) {
+		r.valueScanner.strictNull = strictNull
+	}
+}
+
+// WithCopyBytes enables copying of String and Utf8 values scanned into []byte destinations.
+// Otherwise scanned bytes share memory with result buffer, are valid until next row and must not be modified
+func WithCopyBytes(copyBytes bool) option {
+	return func(r *baseResult) {
+		r.valueScanner.copyBytes = copyBytes
+	}
+}
+
+// WithColumnMatching sets mode of matching of columns passed to NextResultSet with columns of result set
+func WithColumnMatching(columnMatching result.ColumnMatching) option {
+	return func(r *baseResult) {
+		r.valueScanner.columnMatching = columnMatching
+	}
+}
+
 func NewStream(
 	ctx context.Context,
 	recv func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error),
@@ -218,7 +261,40 @@ func (r *streamResult) NextResultSet(ctx context.Context, columns ...string) boo
 
 // CurrentResultSet get current result set
 func (r *baseResult) CurrentResultSet() result.Set {
-	return r
+	return &r.valueScanner
+}
+
+// Columns returns names and types of columns of the current result set
+func (r *baseResult) Columns() []options.Column {
+	columns := make([]options.Column, 0, r.ColumnCount())
+	r.valueScanner.Columns(func(c options.Column) {
+		columns = append(columns, c)
+	})
+
+	return columns
+}
+
+// CloneResultSet returns in-memory copy of the current result set as independent result
+//
+// Copy contains all rows of the current result set and keeps scan options of r.
+func (r *baseResult) CloneResultSet() result.Result {
+	var sets []*Ydb.ResultSet
+	if r.set != nil {
+		sets = append(sets, proto.Clone(r.set).(*Ydb.ResultSet)) //nolint:forcetypeassert
+	}
+
+	return &unaryResult{
+		baseResult: baseResult{
+			valueScanner: valueScanner{
+				ignoreTruncated:          r.ignoreTruncated,
+				markTruncatedAsRetryable: r.markTruncatedAsRetryable,
+				strictNull:               r.strictNull,
+				copyBytes:                r.copyBytes,
+				columnMatching:           r.columnMatching,
+			},
+		},
+		sets: sets,
+	}
 }
 
 // Stats returns query execution queryStats.
@@ -16,6 +16,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
 )
 
 func TestResultAny(t *testing.T) {
@@ -280,3 +282,170 @@ func TestNewStreamWithRecvFirstResultSet(t *testing.T) {
 		})
 	}
 }
+
+func TestResultRowCountsAndTruncated(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	column := options.Column{Name: "id", Type: types.Uint64}
+	full := NewResultSet(a, WithColumns(column), WithValues(value.Uint64Value(1), value.Uint64Value(2)))
+	truncated := NewResultSet(a, WithColumns(column), WithValues(value.Uint64Value(3)))
+	truncated.Truncated = true
+
+	res := NewUnary([]*Ydb.ResultSet{full}, nil)
+	require.Equal(t, []int{2}, res.RowCounts())
+	require.False(t, res.HasTruncatedResultSet())
+
+	res = NewUnary([]*Ydb.ResultSet{full, truncated}, nil)
+	require.Equal(t, []int{2, 1}, res.RowCounts())
+	require.True(t, res.HasTruncatedResultSet())
+	require.True(t, res.NextResultSet(context.Background()))
+	require.False(t, res.CurrentResultSet().Truncated())
+	require.True(t, res.NextResultSet(context.Background()))
+	require.True(t, res.CurrentResultSet().Truncated())
+	require.Equal(t, 1, res.CurrentResultSet().RowCount())
+}
+
+func TestResultColumns(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	columns := []options.Column{
+		{Name: "id", Type: types.Uint64},
+		{Name: "title", Type: types.NewOptional(types.Text)},
+	}
+	set := NewResultSet(a, WithColumns(columns...), WithValues(value.Uint64Value(1), value.NullValue(types.Text)))
+
+	res := NewUnary([]*Ydb.ResultSet{set}, nil)
+	require.Empty(t, res.Columns())
+	require.True(t, res.NextResultSet(context.Background()))
+	require.Equal(t, columns, res.Columns())
+	require.Equal(t, 2, res.CurrentResultSet().ColumnCount())
+}
+
+func TestResultCloneResultSet(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	column := options.Column{Name: "id", Type: types.Uint64}
+	sets := []*Ydb.ResultSet{
+		NewResultSet(a, WithColumns(column), WithValues(value.Uint64Value(1), value.Uint64Value(2))),
+		NewResultSet(a, WithColumns(column), WithValues(value.Uint64Value(3))),
+	}
+	res, err := NewStream(context.Background(),
+		func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+			if len(sets) == 0 {
+				return nil, nil, io.EOF
+			}
+			set := sets[0]
+			sets = sets[1:]
+
+			return set, nil, nil
+		},
+		func(err error) error {
+			return err
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, res.NextResultSet(context.Background()))
+	require.True(t, res.NextRow())
+
+	clone := res.CloneResultSet()
+	ids := make(chan []uint64)
+	go func() {
+		var values []uint64
+		for clone.NextResultSet(context.Background()) {
+			for clone.NextRow() {
+				var id uint64
+				if err := clone.Scan(&id); err != nil {
+					break
+				}
+				values = append(values, id)
+			}
+		}
+		ids <- values
+	}()
+
+	require.True(t, res.NextResultSet(context.Background()))
+	require.True(t, res.NextRow())
+	var id uint64
+	require.NoError(t, res.Scan(&id))
+	require.Equal(t, uint64(3), id)
+	require.Equal(t, []uint64{1, 2}, <-ids)
+	require.NoError(t, clone.Err())
+}
+
+func TestResultColumnMatching(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	set := NewResultSet(a,
+		WithColumns(
+			options.Column{Name: "id", Type: types.Uint64},
+			options.Column{Name: "title", Type: types.Text},
+		),
+		WithValues(value.Uint64Value(1), value.TextValue("text")),
+	)
+	for _, tt := range []struct {
+		name     string
+		matching result.ColumnMatching
+		columns  []string
+		exp      []interface{}
+		err      *result.ErrColumnMismatch
+	}{
+		{
+			name:     "LenientByName",
+			matching: result.ColumnMatchingLenient,
+			columns:  []string{"title"},
+			exp:      []interface{}{"text"},
+		},
+		{
+			name:     "LenientMissing",
+			matching: result.ColumnMatchingLenient,
+			columns:  []string{"id", "name"},
+			err:      &result.ErrColumnMismatch{Column: "name", Missing: true},
+		},
+		{
+			name:     "Strict",
+			matching: result.ColumnMatchingStrict,
+			columns:  []string{"title", "id"},
+			exp:      []interface{}{"text", uint64(1)},
+		},
+		{
+			name:     "StrictExtra",
+			matching: result.ColumnMatchingStrict,
+			columns:  []string{"title"},
+			err:      &result.ErrColumnMismatch{Column: "id"},
+		},
+		{
+			name:     "Positional",
+			matching: result.ColumnMatchingPositional,
+			columns:  []string{"series_id", "series_title"},
+			exp:      []interface{}{uint64(1), "text"},
+		},
+		{
+			name:     "PositionalMissing",
+			matching: result.ColumnMatchingPositional,
+			columns:  []string{"series_id", "series_title", "release_date"},
+			err:      &result.ErrColumnMismatch{Column: "release_date", Missing: true},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			res := NewUnary([]*Ydb.ResultSet{set}, nil, WithColumnMatching(tt.matching))
+			if tt.err != nil {
+				require.True(t, res.NextResultSet(context.Background(), tt.columns...))
+				require.False(t, res.NextRow())
+				var mismatch result.ErrColumnMismatch
+				require.ErrorAs(t, res.Err(), &mismatch)
+				require.Equal(t, *tt.err, mismatch)
+
+				return
+			}
+			require.True(t, res.NextResultSet(context.Background(), tt.columns...))
+			require.True(t, res.NextRow())
+			values := make([]interface{}, len(tt.columns))
+			dst := make([]indexed.RequiredOrOptional, len(values))
+			for i := range values {
+				dst[i] = &values[i]
+			}
+			require.NoError(t, res.Scan(dst...))
+			require.Equal(t, tt.exp, values)
+		})
+	}
+}
@@ -193,7 +193,7 @@ func (s *rawConverter) Timestamp() (v time.Time) {
 func (s *rawConverter) Interval() (v time.Duration) {
 	s.unwrap()
 
-	return value.IntervalToDuration(s.int64())
+	return s.duration()
 }
 
 func (s *rawConverter) TzDate() (v time.Time) {
@@ -247,7 +247,7 @@ func (s *rawConverter) UTF8() (v string) {
 func (s *rawConverter) YSON() (v []byte) {
 	s.unwrap()
 
-	return s.bytes()
+	return s.yson()
 }
 
 func (s *rawConverter) JSON() (v []byte) {
@@ -1,11 +1,13 @@
 package scanner
 
 import (
+	"bytes"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math"
+	"math/big"
 	"reflect"
 	"time"
 
@@ -33,6 +35,9 @@ type valueScanner struct {
 	nextItem                 int
 	ignoreTruncated          bool
 	markTruncatedAsRetryable bool
+	strictNull               bool
+	copyBytes                bool
+	columnMatching           result.ColumnMatching
 
 	columnIndexes []int
 
@@ -199,6 +204,12 @@ func (s *valueScanner) ScanNamed(namedValues ...named.Value) error {
 			s.scanOptional(namedValues[i].Value, false)
 		case named.TypeOptionalWithUseDefault:
 			s.scanOptional(namedValues[i].Value, true)
+		case named.TypeAuto:
+			if s.isCurrentTypeOptional() {
+				s.scanOptional(namedValues[i].Value, false)
+			} else {
+				s.scanRequired(namedValues[i].Value)
+			}
 		default:
 			panic(fmt.Sprintf("unknown type of named.valueType: %d", t))
 		}
@@ -302,7 +313,7 @@ func (s *valueScanner) seekItemByID(id int) error {
 	}
 	col := s.set.GetColumns()[id]
 	s.stack.scanItem.name = col.GetName()
-	s.stack.scanItem.t = col.GetType()
+	s.stack.scanItem.t = untag(col.GetType())
 	s.stack.scanItem.v = s.row.GetItems()[id]
 
 	return nil
@@ -317,7 +328,7 @@ func (s *valueScanner) seekItemByName(name string) error {
 			continue
 		}
 		s.stack.scanItem.name = c.GetName()
-		s.stack.scanItem.t = c.GetType()
+		s.stack.scanItem.t = untag(c.GetType())
 		s.stack.scanItem.v = s.row.GetItems()[i]
 
 		return s.Err()
@@ -333,22 +344,46 @@ func (s *valueScanner) setColumnIndexes(columns []string) {
 		return
 	}
 	s.columnIndexes = make([]int, len(columns))
+	setColumns := s.set.GetColumns()
+	if s.columnMatching == result.ColumnMatchingPositional {
+		for i, col := range columns {
+			if i >= len(setColumns) {
+				_ = s.columnMismatchError(col, true)
+
+				return
+			}
+			s.columnIndexes[i] = i
+		}
+
+		return
+	}
+	matched := make([]bool, len(setColumns))
 	for i, col := range columns {
 		found := false
-		for j, c := range s.set.GetColumns() {
+		for j, c := range setColumns {
 			if c.GetName() == col {
 				s.columnIndexes[i] = j
+				matched[j] = true
 				found = true
 
 				break
 			}
 		}
 		if !found {
-			_ = s.noColumnError(col)
+			_ = s.columnMismatchError(col, true)
 
 			return
 		}
 	}
+	if s.columnMatching == result.ColumnMatchingStrict {
+		for j, c := range setColumns {
+			if !matched[j] {
+				_ = s.columnMismatchError(c.GetName(), false)
+
+				return
+			}
+		}
+	}
 }
 
 // Any returns any primitive or optional value.
@@ -425,7 +460,7 @@ func (s *valueScanner) any() interface{} {
 	case internalTypes.Int64:
 		return s.int64()
 	case internalTypes.Interval:
-		return value.IntervalToDuration(s.int64())
+		return s.duration()
 	case internalTypes.TzDate:
 		src, err := value.TzDateToTime(s.text())
 		if err != nil {
@@ -449,8 +484,9 @@ func (s *valueScanner) any() interface{} {
 		return src
 	case internalTypes.Text, internalTypes.DyNumber:
 		return s.text()
+	case internalTypes.YSON:
+		return s.yson()
 	case
-		internalTypes.YSON,
 		internalTypes.JSON,
 		internalTypes.JSONDocument:
 		return xstring.ToBytes(s.text())
@@ -492,10 +528,22 @@ func (s *valueScanner) unwrap() {
 		return
 	}
 
-	if isOptional(t.OptionalType.GetItem()) {
+	item := untag(t.OptionalType.GetItem())
+	if isOptional(item) {
 		s.stack.scanItem.v = s.unwrapValue()
 	}
-	s.stack.scanItem.t = t.OptionalType.GetItem()
+	s.stack.scanItem.t = item
+}
+
+// untag returns inner type of Tagged type. Tagged values are scanned as values of inner type
+func untag(t *Ydb.Type) *Ydb.Type {
+	for {
+		tagged, ok := t.GetType().(*Ydb.Type_TaggedType)
+		if !ok {
+			return t
+		}
+		t = tagged.TaggedType.GetType()
+	}
 }
 
 func (s *valueScanner) unwrapValue() (v *Ydb.Value) {
@@ -623,6 +671,30 @@ func (s *valueScanner) int64() (v int64) {
 	return x.Int64Value
 }
 
+// pg returns text or binary representation of current item of PostgreSQL type
+func (s *valueScanner) pg() []byte {
+	switch x := s.stack.currentValue().(type) {
+	case *Ydb.Value_TextValue:
+		return xstring.ToBytes(x.TextValue)
+	case *Ydb.Value_BytesValue:
+		return x.BytesValue
+	default:
+		s.valueTypeError(s.stack.currentValue(), (*Ydb.Value_TextValue)(nil))
+
+		return nil
+	}
+}
+
+// duration returns current Interval item as time.Duration with overflow check
+func (s *valueScanner) duration() time.Duration {
+	d, err := value.IntervalToDurationChecked(s.int64())
+	if err != nil {
+		_ = s.errorf(0, "valueScanner.duration(): %w", err)
+	}
+
+	return d
+}
+
 func (s *valueScanner) uint64() (v uint64) {
 	x, _ := s.stack.currentValue().(*Ydb.Value_Uint64Value)
 	if x == nil {
@@ -678,6 +750,20 @@ func (s *valueScanner) text() (v string) {
 	return x.TextValue
 }
 
+// yson returns Yson value which may be transferred as bytes or as text
+func (s *valueScanner) yson() (v []byte) {
+	switch x := s.stack.currentValue().(type) {
+	case *Ydb.Value_BytesValue:
+		return x.BytesValue
+	case *Ydb.Value_TextValue:
+		return xstring.ToBytes(x.TextValue)
+	default:
+		s.valueTypeError(s.stack.currentValue(), x)
+
+		return nil
+	}
+}
+
 func (s *valueScanner) low128() (v uint64) {
 	x, _ := s.stack.currentValue().(*Ydb.Value_Low_128)
 	if x == nil {
@@ -741,12 +827,19 @@ func (s *valueScanner) setTime(dst *time.Time) {
 }
 
 func (s *valueScanner) setString(dst *string) {
+	if s.stack.current().t.GetPgType() != nil {
+		*dst = xstring.FromBytes(s.pg())
+
+		return
+	}
 	switch t := s.stack.current().t.GetTypeId(); t {
 	case Ydb.Type_UUID:
 		src := s.uint128()
 		*dst = xstring.FromBytes(src[:])
-	case Ydb.Type_UTF8, Ydb.Type_DYNUMBER, Ydb.Type_YSON, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT:
+	case Ydb.Type_UTF8, Ydb.Type_DYNUMBER, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT:
 		*dst = s.text()
+	case Ydb.Type_YSON:
+		*dst = xstring.FromBytes(s.yson())
 	case Ydb.Type_STRING:
 		*dst = xstring.FromBytes(s.bytes())
 	default:
@@ -754,20 +847,61 @@ func (s *valueScanner) setString(dst *string) {
 	}
 }
 
+// ownBytes returns copy of b in copy mode or b as is otherwise
+// (b is shared with result buffer and valid until next row)
+func (s *valueScanner) ownBytes(b []byte) []byte {
+	if s.copyBytes {
+		return bytes.Clone(b)
+	}
+
+	return b
+}
+
 func (s *valueScanner) setByte(dst *[]byte) {
+	if s.stack.current().t.GetPgType() != nil {
+		*dst = s.ownBytes(s.pg())
+
+		return
+	}
 	switch t := s.stack.current().t.GetTypeId(); t {
 	case Ydb.Type_UUID:
 		src := s.uint128()
 		*dst = src[:]
-	case Ydb.Type_UTF8, Ydb.Type_DYNUMBER, Ydb.Type_YSON, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT:
-		*dst = xstring.ToBytes(s.text())
+	case Ydb.Type_UTF8, Ydb.Type_DYNUMBER, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT:
+		*dst = s.ownBytes(xstring.ToBytes(s.text()))
+	case Ydb.Type_YSON:
+		*dst = s.ownBytes(s.yson())
 	case Ydb.Type_STRING:
-		*dst = s.bytes()
+		*dst = s.ownBytes(s.bytes())
 	default:
 		_ = s.errorf(0, "scan row failed: incorrect source types %s", t)
 	}
 }
 
+// isPointerToContainer reports whether v is pointer to map, slice or struct with given depth of pointers
+func isPointerToContainer(v interface{}, depth int) bool {
+	t := reflect.TypeOf(v)
+	for i := 0; i < depth; i++ {
+		if t == nil || t.Kind() != reflect.Ptr {
+			return false
+		}
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Struct:
+		return true
+	default:
+		return false
+	}
+}
+
+// setContainer scans Dict, List, Set, Tuple and Struct values into map, slice or struct destination
+func (s *valueScanner) setContainer(dst interface{}) {
+	if err := value.CastTo(s.value(), dst); err != nil {
+		_ = s.errorf(0, "scan row failed: %w", err)
+	}
+}
+
 func (s *valueScanner) trySetByteArray(v interface{}, optional, def bool) bool {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() == reflect.Ptr {
@@ -841,7 +975,7 @@ func (s *valueScanner) scanRequired(v interface{}) {
 	case *time.Time:
 		s.setTime(v)
 	case *time.Duration:
-		*v = value.IntervalToDuration(s.int64())
+		*v = s.duration()
 	case *string:
 		s.setString(v)
 	case *[]byte:
@@ -854,6 +988,9 @@ func (s *valueScanner) scanRequired(v interface{}) {
 		*v = s.value()
 	case *decimal.Decimal:
 		*v = s.unwrapDecimal()
+	case *big.Rat:
+		d := s.unwrapDecimal()
+		v.Set(d.Rat())
 	case scanner.Scanner:
 		err := v.UnmarshalYDB(s.converter)
 		if err != nil {
@@ -877,7 +1014,21 @@ func (s *valueScanner) scanRequired(v interface{}) {
 		if err != nil {
 			_ = s.errorf(0, "json.Unmarshaler error: %w", err)
 		}
+	case scanner.YSONUnmarshaler:
+		if s.getType() != internalTypes.YSON {
+			_ = s.errorf(0, "ydb required type %T not unsupported for applying to YSONUnmarshaler", s.getType())
+
+			return
+		}
+		if err := v.UnmarshalYSON(s.yson()); err != nil {
+			_ = s.errorf(0, "YSONUnmarshaler error: %w", err)
+		}
 	default:
+		if isPointerToContainer(v, 1) {
+			s.setContainer(v)
+
+			return
+		}
 		ok := s.trySetByteArray(v, false, false)
 		if !ok {
 			_ = s.errorf(0, "scan row failed: type %T is unknown", v)
@@ -1002,7 +1153,7 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 		if s.isNull() {
 			*v = nil
 		} else {
-			src := value.IntervalToDuration(s.int64())
+			src := s.duration()
 			*v = &src
 		}
 	case **string:
@@ -1046,6 +1197,13 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 			src := s.unwrapDecimal()
 			*v = &src
 		}
+	case **big.Rat:
+		if s.isNull() {
+			*v = nil
+		} else {
+			d := s.unwrapDecimal()
+			*v = d.Rat()
+		}
 	case scanner.Scanner:
 		err := v.UnmarshalYDB(s.converter)
 		if err != nil {
@@ -1078,17 +1236,47 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 		if err != nil {
 			_ = s.errorf(0, "json.Unmarshaler error: %w", err)
 		}
+	case scanner.YSONUnmarshaler:
+		s.unwrap()
+		if s.getType() != internalTypes.YSON {
+			_ = s.errorf(0, "ydb optional type %T not unsupported for applying to YSONUnmarshaler", s.getType())
+
+			return
+		}
+		var data []byte
+		if !s.isNull() {
+			data = s.yson()
+		}
+		if err := v.UnmarshalYSON(data); err != nil {
+			_ = s.errorf(0, "YSONUnmarshaler error: %w", err)
+		}
 	default:
+		if isPointerToContainer(v, 2) {
+			s.setContainer(v)
+
+			return
+		}
 		s.unwrap()
-		ok := s.trySetByteArray(v, true, false)
-		if !ok {
-			rv := reflect.TypeOf(v)
-			if rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Ptr {
+		rv := reflect.TypeOf(v)
+		if rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Ptr {
+			if !s.trySetByteArray(v, true, false) {
 				_ = s.errorf(0, "scan row failed: type %T is unknown", v)
-			} else {
-				_ = s.errorf(0, "scan row failed: type %T is not optional! use double pointer or sql.Scanner.", v)
 			}
+
+			return
+		}
+		if s.isNull() {
+			if s.strictNull {
+				_ = s.errorf(0, "scan row failed: %w (%T), use double pointer or sql.Scanner",
+					result.ErrNullValue{Column: s.stack.scanItem.name}, v)
+
+				return
+			}
+			s.setDefaultValue(v)
+
+			return
 		}
+		s.scanRequired(v)
 	}
 }
 
@@ -1132,6 +1320,8 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 		*v = s.value()
 	case *decimal.Decimal:
 		*v = decimal.Decimal{}
+	case *big.Rat:
+		v.SetInt64(0)
 	case sql.Scanner:
 		err := v.Scan(nil)
 		if err != nil {
@@ -1147,7 +1337,17 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 		if err != nil {
 			_ = s.errorf(0, "json.Unmarshaler error: %w", err)
 		}
+	case scanner.YSONUnmarshaler:
+		if err := v.UnmarshalYSON(nil); err != nil {
+			_ = s.errorf(0, "YSONUnmarshaler error: %w", err)
+		}
 	default:
+		if isPointerToContainer(v, 1) {
+			rv := reflect.ValueOf(v).Elem()
+			rv.Set(reflect.Zero(rv.Type()))
+
+			return
+		}
 		ok := s.trySetByteArray(v, false, true)
 		if !ok {
 			_ = s.errorf(0, "scan row failed: type %T is unknown", v)
@@ -1211,11 +1411,11 @@ func (s *valueScanner) notFoundColumnName(name string) error {
 	)
 }
 
-func (s *valueScanner) noColumnError(name string) error {
+func (s *valueScanner) columnMismatchError(name string, missing bool) error {
 	return s.errorf(
 		2, //nolint:gomnd
-		"no column %q",
-		name,
+		"%w",
+		result.ErrColumnMismatch{Column: name, Missing: missing},
 	)
 }
 
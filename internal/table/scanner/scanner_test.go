@@ -1,18 +1,24 @@
 package scanner
 
 import (
+	"database/sql"
 	"encoding/binary"
 	"encoding/json"
 	"math"
+	"math/big"
 	"strconv"
 	"testing"
 	"time"
+	"unsafe"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pg"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -777,3 +783,355 @@ func TestScanToJsonUnmarshaller(t *testing.T) {
 		}
 	}
 }
+
+func TestScanDecimalToBigRat(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	decimalValue := value.ToYDB(value.DecimalValueFromBigInt(big.NewInt(-12345), 22, 2), a)
+	optionalValue := value.ToYDB(value.OptionalValue(value.DecimalValueFromBigInt(big.NewInt(1), 22, 9)), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "decimal", Type: decimalValue.GetType()},
+			{Name: "optional", Type: optionalValue.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{decimalValue.GetValue(), optionalValue.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		r  big.Rat
+		or *big.Rat
+	)
+	require.NoError(t, s.Scan(&r, &or))
+	require.Equal(t, "-123.45", r.FloatString(2))
+	require.Equal(t, "0.000000001", or.FloatString(9))
+}
+
+func TestScanOptionalIntoSinglePointer(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	text := value.ToYDB(value.OptionalValue(value.TextValue("text")), a)
+	null := value.ToYDB(value.NullValue(types.TypeText), a)
+	for _, strictNull := range []bool{false, true} {
+		t.Run("strictNull="+strconv.FormatBool(strictNull), func(t *testing.T) {
+			s := initScanner()
+			s.strictNull = strictNull
+			s.reset(&Ydb.ResultSet{
+				Columns: []*Ydb.Column{
+					{Name: "text", Type: text.GetType()},
+					{Name: "null", Type: null.GetType()},
+				},
+				Rows: []*Ydb.Value{{
+					Items: []*Ydb.Value{text.GetValue(), null.GetValue()},
+				}},
+			})
+			require.True(t, s.NextRow())
+			var (
+				textDst string
+				nullDst = "not null"
+			)
+			err := s.Scan(&textDst, &nullDst)
+			require.Equal(t, "text", textDst)
+			if strictNull {
+				var nullErr result.ErrNullValue
+				require.ErrorAs(t, err, &nullErr)
+				require.Equal(t, "null", nullErr.Column)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "", nullDst)
+		})
+	}
+}
+
+func TestScanDictToMap(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	dict := value.ToYDB(value.DictValue(
+		value.DictValueField{K: value.TextValue("a"), V: value.Uint64Value(1)},
+		value.DictValueField{K: value.TextValue("b"), V: value.Uint64Value(2)},
+	), a)
+	optional := value.ToYDB(value.OptionalValue(value.DictValue(
+		value.DictValueField{K: value.Uint32Value(1), V: value.TextValue("a")},
+	)), a)
+	null := value.ToYDB(value.NullValue(types.Dict(types.TypeUint32, types.TypeText)), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "dict", Type: dict.GetType()},
+			{Name: "optional", Type: optional.GetType()},
+			{Name: "null", Type: null.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{dict.GetValue(), optional.GetValue(), null.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		m  map[string]uint64
+		om *map[uint32]string
+		nm = map[uint32]string{1: "a"}
+	)
+	require.NoError(t, s.Scan(&m, &om, &nm))
+	require.Equal(t, map[string]uint64{"a": 1, "b": 2}, m)
+	require.NotNil(t, om)
+	require.Equal(t, map[uint32]string{1: "a"}, *om)
+	require.Nil(t, nm)
+}
+
+func TestScanTaggedAndVariant(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	tagged := value.ToYDB(value.TaggedValue("id", value.Uint64Value(42)), a)
+	optionalTagged := value.ToYDB(value.OptionalValue(value.TaggedValue("name", value.TextValue("text"))), a)
+	variant := value.ToYDB(value.VariantValueTuple(value.TextValue("text"), 1, types.Tuple(types.TypeUint64, types.TypeText)), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "tagged", Type: tagged.GetType()},
+			{Name: "optional", Type: optionalTagged.GetType()},
+			{Name: "variant", Type: variant.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{tagged.GetValue(), optionalTagged.GetValue(), variant.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		id           uint64
+		name         *string
+		variantValue types.Value
+	)
+	require.NoError(t, s.Scan(&id, &name, &variantValue))
+	require.Equal(t, uint64(42), id)
+	require.Equal(t, "text", *name)
+	_, idx, inner, err := types.VariantValue(variantValue)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), idx)
+	var text string
+	require.NoError(t, types.CastTo(inner, &text))
+	require.Equal(t, "text", text)
+}
+
+type ysonDecoder struct {
+	data   []byte
+	called bool
+}
+
+func (d *ysonDecoder) UnmarshalYSON(data []byte) error {
+	d.data, d.called = data, true
+
+	return nil
+}
+
+func TestScanYSON(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	yson := value.ToYDB(value.YSONValue([]byte("{a=1}")), a)
+	null := value.ToYDB(value.NullValue(types.TypeYSON), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "bytes", Type: yson.GetType()},
+			{Name: "string", Type: yson.GetType()},
+			{Name: "decoder", Type: yson.GetType()},
+			{Name: "null", Type: null.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{yson.GetValue(), yson.GetValue(), yson.GetValue(), null.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		b           []byte
+		str         string
+		decoder     ysonDecoder
+		nullDecoder = ysonDecoder{data: []byte("x")}
+	)
+	require.NoError(t, s.Scan(&b, &str, &decoder, &nullDecoder))
+	require.Equal(t, []byte("{a=1}"), b)
+	require.Equal(t, "{a=1}", str)
+	require.Equal(t, []byte("{a=1}"), decoder.data)
+	require.True(t, nullDecoder.called)
+	require.Nil(t, nullDecoder.data)
+}
+
+func TestScanContainers(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	list := value.ToYDB(value.ListValue(value.Uint64Value(1), value.Uint64Value(2)), a)
+	structure := value.ToYDB(value.StructValue(
+		value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+		value.StructValueField{Name: "title", V: value.TextValue("a")},
+	), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "list", Type: list.GetType()},
+			{Name: "struct", Type: structure.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{list.GetValue(), structure.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		ids []uint64
+		row struct {
+			ID    uint64 `ydb:"id"`
+			Title string `ydb:"title"`
+		}
+	)
+	require.NoError(t, s.Scan(&ids, &row))
+	require.Equal(t, []uint64{1, 2}, ids)
+	require.Equal(t, uint64(1), row.ID)
+	require.Equal(t, "a", row.Title)
+}
+
+func TestScanIntervalOverflow(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	interval := value.ToYDB(value.IntervalValue(math.MaxInt64), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{{Name: "interval", Type: interval.GetType()}},
+		Rows:    []*Ydb.Value{{Items: []*Ydb.Value{interval.GetValue()}}},
+	})
+	require.True(t, s.NextRow())
+	var d time.Duration
+	require.Error(t, s.Scan(&d))
+}
+
+func TestScanPg(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	text := value.ToYDB(value.PgValue(pg.OIDInt4, "123"), a)
+	binary := value.ToYDB(value.PgBinaryValue(pg.OIDBytea, []byte{1, 2}), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "text", Type: text.GetType()},
+			{Name: "binary", Type: binary.GetType()},
+			{Name: "optional", Type: text.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{text.GetValue(), binary.GetValue(), text.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		str      string
+		b        []byte
+		optional *string
+	)
+	require.NoError(t, s.ScanNamed(
+		named.Required("text", &str),
+		named.Required("binary", &b),
+		named.Optional("optional", &optional),
+	))
+	require.Equal(t, "123", str)
+	require.Equal(t, []byte{1, 2}, b)
+	require.NotNil(t, optional)
+	require.Equal(t, "123", *optional)
+}
+
+func TestScanNamedAuto(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	id := value.ToYDB(value.Uint64Value(1), a)
+	title := value.ToYDB(value.OptionalValue(value.TextValue("title")), a)
+	null := value.ToYDB(value.NullValue(types.TypeText), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "comment", Type: null.GetType()},
+			{Name: "title", Type: title.GetType()},
+			{Name: "series_id", Type: id.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{null.GetValue(), title.GetValue(), id.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		seriesID     uint64
+		titleDst     *string
+		comment      = "not null"
+		commentPtr   = &comment
+		commentValue = "not null"
+	)
+	require.NoError(t, s.ScanNamed(
+		named.Auto("series_id", &seriesID),
+		named.Auto("title", &titleDst),
+		named.Auto("comment", &commentPtr),
+	))
+	require.Equal(t, uint64(1), seriesID)
+	require.NotNil(t, titleDst)
+	require.Equal(t, "title", *titleDst)
+	require.Nil(t, commentPtr)
+	s.reset(s.set)
+	require.True(t, s.NextRow())
+	require.NoError(t, s.ScanNamed(named.Auto("comment", &commentValue)))
+	require.Equal(t, "", commentValue)
+}
+
+func TestScanBytesCopy(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	blob := value.ToYDB(value.BytesValue([]byte("blob")), a)
+	text := value.ToYDB(value.TextValue("text"), a)
+	for _, copyBytes := range []bool{false, true} {
+		t.Run("copyBytes="+strconv.FormatBool(copyBytes), func(t *testing.T) {
+			s := initScanner()
+			s.copyBytes = copyBytes
+			s.reset(&Ydb.ResultSet{
+				Columns: []*Ydb.Column{
+					{Name: "blob", Type: blob.GetType()},
+					{Name: "text", Type: text.GetType()},
+				},
+				Rows: []*Ydb.Value{{
+					Items: []*Ydb.Value{blob.GetValue(), text.GetValue()},
+				}},
+			})
+			require.True(t, s.NextRow())
+			var blobDst, textDst []byte
+			require.NoError(t, s.Scan(&blobDst, &textDst))
+			require.Equal(t, []byte("blob"), blobDst)
+			require.Equal(t, []byte("text"), textDst)
+			require.Equal(t, !copyBytes, &blobDst[0] == &blob.GetValue().GetBytesValue()[0])
+			require.Equal(t, !copyBytes, &textDst[0] == unsafe.StringData(text.GetValue().GetTextValue()))
+		})
+	}
+}
+
+func TestScanSQLScanner(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	text := value.ToYDB(value.TextValue("text"), a)
+	optional := value.ToYDB(value.OptionalValue(value.Int64Value(42)), a)
+	null := value.ToYDB(value.NullValue(types.TypeText), a)
+	s := initScanner()
+	s.reset(&Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "text", Type: text.GetType()},
+			{Name: "optional", Type: optional.GetType()},
+			{Name: "null", Type: null.GetType()},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{text.GetValue(), optional.GetValue(), null.GetValue()},
+		}},
+	})
+	require.True(t, s.NextRow())
+	var (
+		str   sql.NullString
+		num   sql.NullInt64
+		empty = sql.NullString{String: "text", Valid: true}
+	)
+	require.NoError(t, s.Scan(&str, &num, &empty))
+	require.Equal(t, sql.NullString{String: "text", Valid: true}, str)
+	require.Equal(t, sql.NullInt64{Int64: 42, Valid: true}, num)
+	require.Equal(t, sql.NullString{}, empty)
+}
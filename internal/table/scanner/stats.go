@@ -95,6 +95,8 @@ func (q *queryPhase) NextTableAccess() (t *stats.TableAccess, ok bool) {
 		Reads:   initOperationStats(x.GetReads()),
 		Updates: initOperationStats(x.GetUpdates()),
 		Deletes: initOperationStats(x.GetDeletes()),
+
+		PartitionsCount: x.GetPartitionsCount(),
 	}, true
 }
 
@@ -3,6 +3,7 @@ package table
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
 	"sync"
@@ -15,8 +16,10 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/feature"
@@ -54,6 +57,8 @@ type session struct {
 	statusMtx    sync.RWMutex
 	closeOnce    sync.Once
 	nodeID       atomic.Uint32
+	writes       atomic.Bool // session made calls which may change data
+	statements   *statementsCache
 }
 
 func (s *session) LastUsage() time.Time {
@@ -101,8 +106,15 @@ func (s *session) Status() table.SessionStatus {
 
 func (s *session) SetStatus(status table.SessionStatus) {
 	s.statusMtx.Lock()
-	defer s.statusMtx.Unlock()
+	changed := s.status != status
 	s.status = status
+	s.statusMtx.Unlock()
+
+	if changed && s.config != nil {
+		for _, onStateChanged := range s.config.OnSessionStateChanged() {
+			onStateChanged(s, status)
+		}
+	}
 }
 
 func (s *session) isClosed() bool {
@@ -150,19 +162,29 @@ func newSession(ctx context.Context, cc grpc.ClientConnInterface, config *config
 		config: config,
 		status: table.SessionReady,
 	}
+	if size := config.PreparedStatementsCacheSize(); size > 0 {
+		s.statements = newStatementsCache(size)
+	}
 	s.lastUsage.Store(time.Now().Unix())
 
 	s.tableService = Ydb_Table_V1.NewTableServiceClient(
 		conn.WithBeforeFunc(
-			conn.WithContextModifier(cc, func(ctx context.Context) context.Context {
-				return meta.WithTrailerCallback(balancerContext.WithEndpoint(ctx, s), s.checkCloseHint)
-			}),
+			conn.WithContextModifier(
+				writesTracker{ClientConnInterface: cc, s: s},
+				func(ctx context.Context) context.Context {
+					return meta.WithTrailerCallback(balancerContext.WithEndpoint(ctx, s), s.checkCloseHint)
+				},
+			),
 			func() {
 				s.lastUsage.Store(time.Now().Unix())
 			},
 		),
 	)
 
+	for _, onCreated := range config.OnSessionCreated() {
+		onCreated(s)
+	}
+
 	return s, nil
 }
 
@@ -186,6 +208,9 @@ func (s *session) Close(ctx context.Context) (err error) {
 		)
 		defer func() {
 			s.SetStatus(table.SessionClosed)
+			for _, onDeleted := range s.config.OnSessionDeleted() {
+				onDeleted(s)
+			}
 			onDone(err)
 		}()
 
@@ -454,6 +479,7 @@ func (s *session) DescribeTable(
 		TimeToLiveSettings:   NewTimeToLiveSettings(result.GetTtlSettings()),
 		Changefeeds:          changeFeeds,
 		Tiering:              result.GetTiering(),
+		StoreType:            options.NewStoreType(result.GetSelf().GetType()),
 	}, nil
 }
 
@@ -483,11 +509,11 @@ func (s *session) DropTable(
 	return xerrors.WithStackTrace(err)
 }
 
-func (s *session) checkError(err error) {
+func (s *session) checkError(err error, opts ...retry.Option) {
 	if err == nil {
 		return
 	}
-	if m := retry.Check(err); m.IsRetryObjectValid() {
+	if m := retry.Check(err, opts...); m.IsRetryObjectValid() {
 		s.SetStatus(table.SessionClosing)
 	}
 }
@@ -673,7 +699,7 @@ func (s *session) Explain(
 		if err != nil {
 			onDone("", "", err)
 		} else {
-			onDone(exp.AST, exp.AST, nil)
+			onDone(exp.AST, exp.Plan, nil)
 		}
 	}()
 
@@ -710,6 +736,7 @@ func (s *session) Explain(
 func (s *session) Prepare(ctx context.Context, queryText string) (_ table.Statement, err error) {
 	var (
 		stmt     *statement
+		cacheHit bool
 		response *Ydb_Table.PrepareDataQueryResponse
 		result   Ydb_Table.PrepareQueryResult
 		onDone   = trace.TableOnSessionQueryPrepare(
@@ -720,12 +747,18 @@ func (s *session) Prepare(ctx context.Context, queryText string) (_ table.Statem
 	)
 	defer func() {
 		if err != nil {
-			onDone(nil, err)
+			onDone(nil, cacheHit, s.statements.len(), err)
 		} else {
-			onDone(stmt.query, nil)
+			onDone(stmt.query, cacheHit, s.statements.len(), nil)
 		}
 	}()
 
+	if s.statements != nil {
+		if stmt, cacheHit = s.statements.get(queryText); cacheHit {
+			return stmt, nil
+		}
+	}
+
 	response, err = s.tableService.PrepareDataQuery(ctx,
 		&Ydb_Table.PrepareDataQueryRequest{
 			SessionId: s.id,
@@ -753,6 +786,10 @@ func (s *session) Prepare(ctx context.Context, queryText string) (_ table.Statem
 		params:  result.GetParametersTypes(),
 	}
 
+	if s.statements != nil {
+		s.statements.put(stmt)
+	}
+
 	return stmt, nil
 }
 
@@ -772,6 +809,7 @@ func (s *session) Execute(
 		request = options.ExecuteDataQueryDesc{
 			ExecuteDataQueryRequest: a.TableExecuteDataQueryRequest(),
 			IgnoreTruncated:         s.config.IgnoreTruncated(),
+			StrictNullScan:          s.config.StrictNullScan(),
 		}
 		callOptions []grpc.CallOption
 	)
@@ -782,7 +820,7 @@ func (s *session) Execute(
 	request.Parameters = parameters.ToYDB(a)
 	request.Query = q.toYDB(a)
 	request.QueryCachePolicy = a.TableQueryCachePolicy()
-	request.QueryCachePolicy.KeepInCache = len(request.Parameters) > 0
+	request.QueryCachePolicy.KeepInCache = s.config.KeepInCache() || len(request.Parameters) > 0
 	request.OperationParams = operation.Params(ctx,
 		s.config.OperationTimeout(),
 		s.config.OperationCancelAfter(),
@@ -810,7 +848,7 @@ func (s *session) Execute(
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	return s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated)
+	return s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated, request.StrictNullScan)
 }
 
 // executeQueryResult returns Transaction and result built from received
@@ -819,6 +857,7 @@ func (s *session) executeQueryResult(
 	res *Ydb_Table.ExecuteQueryResult,
 	txControl *Ydb_Table.TransactionControl,
 	ignoreTruncated bool,
+	strictNull bool,
 ) (
 	table.Transaction, result.Result, error,
 ) {
@@ -837,6 +876,9 @@ func (s *session) executeQueryResult(
 		res.GetResultSets(),
 		res.GetQueryStats(),
 		scanner.WithIgnoreTruncated(ignoreTruncated),
+		scanner.WithStrictNull(strictNull),
+		scanner.WithCopyBytes(s.config.CopyBytesScan()),
+		scanner.WithColumnMatching(s.config.ColumnMatching()),
 	), nil
 }
 
@@ -1044,8 +1086,10 @@ func (s *session) StreamReadTable(
 			SessionId: s.id,
 			Path:      path,
 		}
-		stream Ydb_Table_V1.TableService_StreamReadTableClient
-		a      = allocator.New()
+		stream   Ydb_Table_V1.TableService_StreamReadTableClient
+		resume   options.ReadTableResumeDesc
+		snapshot options.ReadTableSnapshotDesc
+		a        = allocator.New()
 	)
 	defer func() {
 		a.Free()
@@ -1055,6 +1099,12 @@ func (s *session) StreamReadTable(
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyReadTableOption((*options.ReadTableDesc)(&request), a)
+			if resumeOpt, ok := opt.(options.ReadTableResumeOption); ok {
+				resumeOpt.ApplyReadTableResumeOption(&resume)
+			}
+			if snapshotOpt, ok := opt.(options.ReadTableSnapshotOption); ok {
+				snapshotOpt.ApplyReadTableSnapshotOption(&snapshot)
+			}
 		}
 	}
 
@@ -1067,6 +1117,23 @@ func (s *session) StreamReadTable(
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	recv := func(ctx context.Context) (*Ydb.ResultSet, error) {
+		response, err := stream.Recv()
+		readTableSnapshot(response, snapshot.OnSnapshot)
+		result := response.GetResult()
+		if result == nil || err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return result.GetResultSet(), nil
+	}
+	if len(resume.KeyColumns) > 0 {
+		recv = s.resumableStreamReadTable(stream,
+			proto.Clone(&request).(*Ydb_Table.ReadTableRequest), //nolint:forcetypeassert
+			resume, snapshot.OnSnapshot,
+		)
+	}
+
 	return scanner.NewStream(ctx,
 		func(ctx context.Context) (
 			set *Ydb.ResultSet,
@@ -1077,14 +1144,9 @@ func (s *session) StreamReadTable(
 			case <-ctx.Done():
 				return nil, nil, xerrors.WithStackTrace(ctx.Err())
 			default:
-				var response *Ydb_Table.ReadTableResponse
-				response, err = stream.Recv()
-				result := response.GetResult()
-				if result == nil || err != nil {
-					return nil, nil, xerrors.WithStackTrace(err)
-				}
+				set, err = recv(ctx)
 
-				return result.GetResultSet(), nil, nil
+				return set, nil, err
 			}
 		},
 		func(err error) error {
@@ -1094,9 +1156,142 @@ func (s *session) StreamReadTable(
 			return err
 		},
 		scanner.WithIgnoreTruncated(true), // stream read table always returns truncated flag on last result set
+		scanner.WithStrictNull(s.config.StrictNullScan()),
+		scanner.WithCopyBytes(s.config.CopyBytesScan()),
+		scanner.WithColumnMatching(s.config.ColumnMatching()),
 	)
 }
 
+// readTableMaxResumeAttempts limits count of consecutive re-openings of read table stream
+const readTableMaxResumeAttempts = 10
+
+// resumableStreamReadTable returns receiver of result sets which re-opens read table
+// stream after the last received key on retryable stream errors
+//
+// Stream is re-opened at most readTableMaxResumeAttempts times in a row without received
+// result sets. Row limit of re-opened stream is decreased by count of already received rows.
+//
+//nolint:funlen
+func (s *session) resumableStreamReadTable(
+	stream Ydb_Table_V1.TableService_StreamReadTableClient,
+	request *Ydb_Table.ReadTableRequest,
+	resume options.ReadTableResumeDesc,
+	onSnapshot func(snapshot options.ReadTableSnapshot),
+) func(ctx context.Context) (*Ydb.ResultSet, error) {
+	rowLimitReached := false
+
+	return func(ctx context.Context) (*Ydb.ResultSet, error) {
+		for attempt := 0; ; attempt++ {
+			response, err := stream.Recv()
+			readTableSnapshot(response, onSnapshot)
+			if err == nil {
+				set := response.GetResult().GetResultSet()
+				if limit := request.GetRowLimit(); limit > 0 {
+					if n := uint64(len(set.GetRows())); n >= limit {
+						rowLimitReached = true
+					} else {
+						request.RowLimit = limit - n
+					}
+				}
+				lastKey, keyErr := readTableLastKey(set, resume.KeyColumns)
+				if keyErr != nil {
+					return nil, xerrors.WithStackTrace(keyErr)
+				}
+				if lastKey != nil {
+					if request.GetKeyRange() == nil {
+						request.KeyRange = &Ydb_Table.KeyRange{}
+					}
+					request.KeyRange.FromBound = &Ydb_Table.KeyRange_Greater{
+						Greater: lastKey,
+					}
+					if resume.Checkpoint != nil {
+						resume.Checkpoint(value.FromYDB(lastKey.GetType(), lastKey.GetValue()))
+					}
+				}
+
+				return set, nil
+			}
+			if xerrors.Is(err, io.EOF) {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			if _, errType, _, _ := xerrors.Check(err); errType != xerrors.TypeRetryable &&
+				errType != xerrors.TypeConditionallyRetryable {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			if rowLimitReached {
+				// all requested rows are received, re-opened stream without limit would read more rows
+				return nil, xerrors.WithStackTrace(io.EOF)
+			}
+			if attempt >= readTableMaxResumeAttempts {
+				return nil, xerrors.WithStackTrace(fmt.Errorf(
+					"read table stream is not resumed after %d attempts: %w", attempt, err,
+				))
+			}
+			select {
+			case <-ctx.Done():
+				return nil, xerrors.WithStackTrace(xerrors.Join(ctx.Err(), err))
+			case <-time.After(backoff.Fast.Delay(attempt)):
+			}
+			stream, err = s.tableService.StreamReadTable(ctx, request)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+	}
+}
+
+// readTableSnapshot calls onSnapshot with snapshot of response if any
+func readTableSnapshot(response *Ydb_Table.ReadTableResponse, onSnapshot func(snapshot options.ReadTableSnapshot)) {
+	if onSnapshot == nil || response.GetSnapshot() == nil {
+		return
+	}
+	onSnapshot(options.ReadTableSnapshot{
+		PlanStep: response.GetSnapshot().GetPlanStep(),
+		TxID:     response.GetSnapshot().GetTxId(),
+	})
+}
+
+// readTableLastKey returns key of the last row in set as tuple of key columns values
+func readTableLastKey(set *Ydb.ResultSet, keyColumns []string) (*Ydb.TypedValue, error) {
+	rows := set.GetRows()
+	if len(rows) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	var (
+		last     = rows[len(rows)-1]
+		elements = make([]*Ydb.Type, len(keyColumns))
+		items    = make([]*Ydb.Value, len(keyColumns))
+	)
+	for i, name := range keyColumns {
+		idx := -1
+		for j, column := range set.GetColumns() {
+			if column.GetName() == name {
+				idx = j
+
+				break
+			}
+		}
+		if idx < 0 || idx >= len(last.GetItems()) {
+			return nil, fmt.Errorf("key column %q not found in read table result", name)
+		}
+		elements[i] = set.GetColumns()[idx].GetType()
+		items[i] = last.GetItems()[idx]
+	}
+
+	return &Ydb.TypedValue{
+		Type: &Ydb.Type{
+			Type: &Ydb.Type_TupleType{
+				TupleType: &Ydb.TupleType{
+					Elements: elements,
+				},
+			},
+		},
+		Value: &Ydb.Value{
+			Items: items,
+		},
+	}, nil
+}
+
 func (s *session) ReadRows(
 	ctx context.Context,
 	path string,
@@ -1137,6 +1332,9 @@ func (s *session) ReadRows(
 		[]*Ydb.ResultSet{response.GetResultSet()},
 		nil,
 		scanner.WithIgnoreTruncated(s.config.IgnoreTruncated()),
+		scanner.WithStrictNull(s.config.StrictNullScan()),
+		scanner.WithCopyBytes(s.config.CopyBytesScan()),
+		scanner.WithColumnMatching(s.config.ColumnMatching()),
 	), nil
 }
 
@@ -1214,6 +1412,9 @@ func (s *session) StreamExecuteScanQuery(
 			return err
 		},
 		scanner.WithIgnoreTruncated(s.config.IgnoreTruncated()),
+		scanner.WithStrictNull(s.config.StrictNullScan()),
+		scanner.WithCopyBytes(s.config.CopyBytesScan()),
+		scanner.WithColumnMatching(s.config.ColumnMatching()),
 		scanner.WithMarkTruncatedAsRetryable(),
 	)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 	"time"
@@ -16,19 +17,25 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestSessionKeepAlive(t *testing.T) {
@@ -85,6 +92,50 @@ func TestSessionKeepAlive(t *testing.T) {
 	}
 }
 
+func TestSessionLifecycleCallbacks(t *testing.T) {
+	var (
+		created  []string
+		deleted  []string
+		statuses []table.SessionStatus
+	)
+	s, err := newSession(context.Background(),
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.DeleteSessionResponse{}, nil
+					},
+				},
+			),
+		),
+		config.New(
+			config.WithOnSessionCreated(func(s table.SessionInfo) {
+				created = append(created, s.ID())
+			}),
+			config.WithOnSessionDeleted(func(s table.SessionInfo) {
+				deleted = append(deleted, s.ID())
+			}),
+			config.WithOnSessionStateChanged(func(s table.SessionInfo, status table.SessionStatus) {
+				statuses = append(statuses, status)
+			}),
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{s.ID()}, created)
+	require.Empty(t, deleted)
+
+	s.SetStatus(table.SessionBusy)
+	s.SetStatus(table.SessionBusy)
+	require.NoError(t, s.Close(context.Background()))
+	require.Equal(t, []string{s.ID()}, deleted)
+	require.Equal(t, []table.SessionStatus{table.SessionBusy, table.SessionClosed}, statuses)
+}
+
 func TestSessionDescribeTable(t *testing.T) {
 	ctx, cancel := xcontext.WithCancel(context.Background())
 	defer cancel()
@@ -214,6 +265,78 @@ func TestSessionDescribeTable(t *testing.T) {
 	}
 }
 
+func TestSessionDescribeTableStats(t *testing.T) {
+	ctx := xtest.Context(t)
+	var request *Ydb_Table.DescribeTableRequest
+	b := StubBuilder{
+		T: t,
+		cc: testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDescribeTable: func(req interface{}) (proto.Message, error) {
+						request, _ = proto.Clone(req.(*Ydb_Table.DescribeTableRequest)).(*Ydb_Table.DescribeTableRequest)
+
+						uint64Bound := func(v uint64) *Ydb.TypedValue {
+							return &Ydb.TypedValue{
+								Type:  &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+								Value: &Ydb.Value{Value: &Ydb.Value_Uint64Value{Uint64Value: v}},
+							}
+						}
+
+						return &Ydb_Table.DescribeTableResult{
+							ShardKeyBounds: []*Ydb.TypedValue{
+								uint64Bound(100),
+								uint64Bound(200),
+							},
+							TableStats: &Ydb_Table.TableStats{
+								PartitionStats: []*Ydb_Table.PartitionStats{
+									{RowsEstimate: 10, StoreSize: 100},
+									{RowsEstimate: 20, StoreSize: 200},
+								},
+								RowsEstimate: 30,
+								StoreSize:    300,
+								Partitions:   2,
+							},
+						}, nil
+					},
+				},
+			),
+		),
+	}
+	s, err := b.createSession(ctx)
+	require.NoError(t, err)
+	d, err := s.DescribeTable(ctx, "/local/test",
+		options.WithShardKeyBounds(),
+		options.WithTableStats(),
+		options.WithPartitionStats(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "/local/test", request.GetPath())
+	require.True(t, request.GetIncludeShardKeyBounds())
+	require.True(t, request.GetIncludeTableStats())
+	require.True(t, request.GetIncludePartitionStats())
+	require.Equal(t, []options.KeyRange{
+		{To: value.Uint64Value(100)},
+		{From: value.Uint64Value(100), To: value.Uint64Value(200)},
+		{From: value.Uint64Value(200)},
+	}, d.KeyRanges)
+	require.Equal(t, "[NULL,100ul]", d.KeyRanges[0].String())
+	require.Equal(t, &options.TableStats{
+		PartitionStats: []options.PartitionStats{
+			{RowsEstimate: 10, StoreSize: 100},
+			{RowsEstimate: 20, StoreSize: 200},
+		},
+		RowsEstimate: 30,
+		StoreSize:    300,
+		Partitions:   2,
+	}, d.Stats)
+}
+
 func TestSessionOperationModeOnExecuteDataQuery(t *testing.T) {
 	fromTo := [...]struct {
 		srcMode operation.Mode
@@ -892,3 +1015,425 @@ func TestRenameTables(t *testing.T) {
 		})
 	}
 }
+
+type readRowsMock struct {
+	Ydb_Table_V1.TableServiceClient
+
+	request  *Ydb_Table.ReadRowsRequest
+	response *Ydb_Table.ReadRowsResponse
+}
+
+func (mock *readRowsMock) ReadRows(
+	_ context.Context, in *Ydb_Table.ReadRowsRequest, opts ...grpc.CallOption,
+) (*Ydb_Table.ReadRowsResponse, error) {
+	mock.request, _ = proto.Clone(in).(*Ydb_Table.ReadRowsRequest)
+
+	return mock.response, nil
+}
+
+func TestSessionReadRows(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("OK", func(t *testing.T) {
+		mock := &readRowsMock{
+			response: &Ydb_Table.ReadRowsResponse{
+				Status: Ydb.StatusIds_SUCCESS,
+				ResultSet: &Ydb.ResultSet{
+					Columns: []*Ydb.Column{
+						{
+							Name: "id",
+							Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+						},
+					},
+					Rows: []*Ydb.Value{
+						{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}}}},
+						{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 2}}}},
+					},
+				},
+			},
+		}
+		s := &session{
+			id:           "test",
+			tableService: mock,
+			config:       config.New(),
+		}
+		res, err := s.ReadRows(ctx, "/local/test",
+			value.ListValue(
+				value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(1)}),
+				value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(2)}),
+			),
+			options.ReadColumn("id"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "test", mock.request.GetSessionId())
+		require.Equal(t, "/local/test", mock.request.GetPath())
+		require.Equal(t, []string{"id"}, mock.request.GetColumns())
+		require.Len(t, mock.request.GetKeys().GetValue().GetItems(), 2)
+		require.True(t, res.NextResultSet(ctx))
+		require.Equal(t, 2, res.CurrentResultSet().RowCount())
+	})
+	t.Run("Error", func(t *testing.T) {
+		s := &session{
+			id: "test",
+			tableService: &readRowsMock{
+				response: &Ydb_Table.ReadRowsResponse{
+					Status: Ydb.StatusIds_SCHEME_ERROR,
+				},
+			},
+			config: config.New(),
+		}
+		_, err := s.ReadRows(ctx, "/local/test", value.ListValue(
+			value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(1)}),
+		))
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR))
+	})
+}
+
+type explainMock struct {
+	Ydb_Table_V1.TableServiceClient
+
+	result *Ydb_Table.ExplainQueryResult
+}
+
+func (mock *explainMock) ExplainDataQuery(
+	_ context.Context, in *Ydb_Table.ExplainDataQueryRequest, opts ...grpc.CallOption,
+) (*Ydb_Table.ExplainDataQueryResponse, error) {
+	result, err := anypb.New(mock.result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ydb_Table.ExplainDataQueryResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: result,
+		},
+	}, nil
+}
+
+func TestSessionExplain(t *testing.T) {
+	ctx := xtest.Context(t)
+	var done trace.TableExplainQueryDoneInfo
+	s := &session{
+		id: "test",
+		tableService: &explainMock{
+			result: &Ydb_Table.ExplainQueryResult{
+				QueryAst:  "(ast)",
+				QueryPlan: `{"Plan":{}}`,
+			},
+		},
+		config: config.New(config.WithTrace(&trace.Table{
+			OnSessionQueryExplain: func(trace.TableExplainQueryStartInfo) func(trace.TableExplainQueryDoneInfo) {
+				return func(info trace.TableExplainQueryDoneInfo) {
+					done = info
+				}
+			},
+		})),
+	}
+	exp, err := s.Explain(ctx, "SELECT 1")
+	require.NoError(t, err)
+	require.Equal(t, "(ast)", exp.AST)
+	require.Equal(t, `{"Plan":{}}`, exp.Plan)
+	require.Equal(t, "(ast)", done.AST)
+	require.Equal(t, `{"Plan":{}}`, done.Plan)
+}
+
+type readTableStreamMock struct {
+	Ydb_Table_V1.TableService_StreamReadTableClient
+
+	responses []*Ydb_Table.ReadTableResponse
+	err       error
+}
+
+func (stream *readTableStreamMock) Recv() (*Ydb_Table.ReadTableResponse, error) {
+	if len(stream.responses) == 0 {
+		return nil, stream.err
+	}
+	response := stream.responses[0]
+	stream.responses = stream.responses[1:]
+
+	return response, nil
+}
+
+type streamReadTableMock struct {
+	Ydb_Table_V1.TableServiceClient
+
+	requests []*Ydb_Table.ReadTableRequest
+	streams  []*readTableStreamMock
+}
+
+func (mock *streamReadTableMock) StreamReadTable(
+	_ context.Context, in *Ydb_Table.ReadTableRequest, opts ...grpc.CallOption,
+) (Ydb_Table_V1.TableService_StreamReadTableClient, error) {
+	request, _ := proto.Clone(in).(*Ydb_Table.ReadTableRequest)
+	mock.requests = append(mock.requests, request)
+	stream := mock.streams[0]
+	mock.streams = mock.streams[1:]
+
+	return stream, nil
+}
+
+func readTableResponse(ids ...uint64) *Ydb_Table.ReadTableResponse {
+	rows := make([]*Ydb.Value, 0, len(ids))
+	for _, id := range ids {
+		rows = append(rows, &Ydb.Value{
+			Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: id}}},
+		})
+	}
+
+	return &Ydb_Table.ReadTableResponse{
+		Status: Ydb.StatusIds_SUCCESS,
+		Result: &Ydb_Table.ReadTableResult{
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{{
+					Name: "id",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+				}},
+				Rows: rows,
+			},
+		},
+	}
+}
+
+func TestSessionStreamReadTableResumable(t *testing.T) {
+	ctx := xtest.Context(t)
+	mock := &streamReadTableMock{
+		streams: []*readTableStreamMock{
+			{
+				responses: []*Ydb_Table.ReadTableResponse{readTableResponse(1, 2)},
+				err:       xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+			},
+			{
+				responses: []*Ydb_Table.ReadTableResponse{readTableResponse(3)},
+				err:       io.EOF,
+			},
+		},
+	}
+	s := &session{
+		id:           "test",
+		tableService: mock,
+		config:       config.New(),
+	}
+	var checkpoints []value.Value
+	res, err := s.StreamReadTable(ctx, "/local/test",
+		options.ReadLess(value.TupleValue(value.Uint64Value(10))),
+		options.ReadResumable([]string{"id"}, func(lastKey value.Value) {
+			checkpoints = append(checkpoints, lastKey)
+		}),
+	)
+	require.NoError(t, err)
+	var ids []uint64
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			var id uint64
+			require.NoError(t, res.Scan(&id))
+			ids = append(ids, id)
+		}
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, []uint64{1, 2, 3}, ids)
+	require.Equal(t, []value.Value{
+		value.TupleValue(value.Uint64Value(2)),
+		value.TupleValue(value.Uint64Value(3)),
+	}, checkpoints)
+	require.Len(t, mock.requests, 2)
+	require.True(t, mock.requests[0].GetOrdered())
+	require.Nil(t, mock.requests[0].GetKeyRange().GetGreater())
+	require.Equal(t, uint64(2),
+		mock.requests[1].GetKeyRange().GetGreater().GetValue().GetItems()[0].GetUint64Value(),
+	)
+	require.Equal(t, uint64(10),
+		mock.requests[1].GetKeyRange().GetLess().GetValue().GetItems()[0].GetUint64Value(),
+	)
+}
+
+func TestSessionStreamReadTableResumableRowLimit(t *testing.T) {
+	unavailable := xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+	for _, tt := range []struct {
+		name     string
+		streams  []*readTableStreamMock
+		ids      []uint64
+		limits   []uint64
+		requests int
+	}{
+		{
+			name: "Resumed",
+			streams: []*readTableStreamMock{
+				{responses: []*Ydb_Table.ReadTableResponse{readTableResponse(1, 2)}, err: unavailable},
+				{responses: []*Ydb_Table.ReadTableResponse{readTableResponse(3)}, err: io.EOF},
+			},
+			ids:    []uint64{1, 2, 3},
+			limits: []uint64{3, 1},
+		},
+		{
+			name: "Reached",
+			streams: []*readTableStreamMock{
+				{responses: []*Ydb_Table.ReadTableResponse{readTableResponse(1, 2, 3)}, err: unavailable},
+			},
+			ids:    []uint64{1, 2, 3},
+			limits: []uint64{3},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			mock := &streamReadTableMock{streams: tt.streams}
+			s := &session{
+				id:           "test",
+				tableService: mock,
+				config:       config.New(),
+			}
+			res, err := s.StreamReadTable(ctx, "/local/test",
+				options.ReadRowLimit(3),
+				options.ReadResumable([]string{"id"}, nil),
+			)
+			require.NoError(t, err)
+			var ids []uint64
+			for res.NextResultSet(ctx) {
+				for res.NextRow() {
+					var id uint64
+					require.NoError(t, res.Scan(&id))
+					ids = append(ids, id)
+				}
+			}
+			require.NoError(t, res.Err())
+			require.Equal(t, tt.ids, ids)
+			limits := make([]uint64, 0, len(mock.requests))
+			for _, request := range mock.requests {
+				limits = append(limits, request.GetRowLimit())
+			}
+			require.Equal(t, tt.limits, limits)
+		})
+	}
+}
+
+func TestSessionStreamReadTableResumableMaxAttempts(t *testing.T) {
+	ctx := xtest.Context(t)
+	mock := &streamReadTableMock{}
+	for i := 0; i <= readTableMaxResumeAttempts; i++ {
+		mock.streams = append(mock.streams, &readTableStreamMock{
+			err: xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+		})
+	}
+	s := &session{
+		id:           "test",
+		tableService: mock,
+		config:       config.New(),
+	}
+	_, err := s.StreamReadTable(ctx, "/local/test",
+		options.ReadResumable([]string{"id"}, nil),
+	)
+	require.True(t, xerrors.IsTransportError(err, grpcCodes.Unavailable))
+	require.Len(t, mock.requests, readTableMaxResumeAttempts+1)
+}
+
+func TestSessionStreamReadTableSnapshot(t *testing.T) {
+	ctx := xtest.Context(t)
+	first := readTableResponse(1)
+	first.Snapshot = &Ydb.VirtualTimestamp{PlanStep: 100, TxId: 200}
+	mock := &streamReadTableMock{
+		streams: []*readTableStreamMock{
+			{
+				responses: []*Ydb_Table.ReadTableResponse{first, readTableResponse(2)},
+				err:       io.EOF,
+			},
+		},
+	}
+	s := &session{
+		id:           "test",
+		tableService: mock,
+		config:       config.New(),
+	}
+	var snapshots []options.ReadTableSnapshot
+	res, err := s.StreamReadTable(ctx, "/local/test",
+		options.ReadSnapshot(func(snapshot options.ReadTableSnapshot) {
+			snapshots = append(snapshots, snapshot)
+		}),
+	)
+	require.NoError(t, err)
+	rows := 0
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			rows++
+		}
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, 2, rows)
+	require.Equal(t, []options.ReadTableSnapshot{{PlanStep: 100, TxID: 200}}, snapshots)
+	require.Len(t, mock.requests, 1)
+	require.Equal(t, Ydb.FeatureFlag_ENABLED, mock.requests[0].GetUseSnapshot())
+}
+
+type executeDataQueryMock struct {
+	Ydb_Table_V1.TableServiceClient
+
+	request *Ydb_Table.ExecuteDataQueryRequest
+}
+
+func (mock *executeDataQueryMock) ExecuteDataQuery(
+	_ context.Context, in *Ydb_Table.ExecuteDataQueryRequest, opts ...grpc.CallOption,
+) (*Ydb_Table.ExecuteDataQueryResponse, error) {
+	mock.request, _ = proto.Clone(in).(*Ydb_Table.ExecuteDataQueryRequest)
+	result, err := anypb.New(&Ydb_Table.ExecuteQueryResult{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ydb_Table.ExecuteDataQueryResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: result,
+		},
+	}, nil
+}
+
+func TestSessionExecuteKeepInCache(t *testing.T) {
+	ctx := xtest.Context(t)
+	for _, tt := range []struct {
+		name        string
+		config      *config.Config
+		params      *params.Parameters
+		opts        []options.ExecuteDataQueryOption
+		keepInCache bool
+	}{
+		{
+			name:        "Default",
+			config:      config.New(),
+			params:      table.NewQueryParameters(),
+			keepInCache: false,
+		},
+		{
+			name:        "DefaultWithParams",
+			config:      config.New(),
+			params:      table.NewQueryParameters(table.ValueParam("$a", value.Uint64Value(1))),
+			keepInCache: true,
+		},
+		{
+			name:        "ClientKeepInCache",
+			config:      config.New(config.WithKeepInCache()),
+			params:      table.NewQueryParameters(),
+			keepInCache: true,
+		},
+		{
+			name:        "ClientKeepInCacheCallOptOut",
+			config:      config.New(config.WithKeepInCache()),
+			params:      table.NewQueryParameters(),
+			opts:        []options.ExecuteDataQueryOption{options.WithKeepInCache(false)},
+			keepInCache: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &executeDataQueryMock{}
+			s := &session{
+				id:           "test",
+				tableService: mock,
+				config:       tt.config,
+			}
+			_, _, err := s.Execute(ctx, table.DefaultTxControl(), "SELECT 1", tt.params, tt.opts...)
+			require.NoError(t, err)
+			require.Equal(t, tt.keepInCache, mock.request.GetQueryCachePolicy().GetKeepInCache())
+		})
+	}
+}
@@ -37,6 +37,7 @@ func (s *statement) Execute(
 		request = options.ExecuteDataQueryDesc{
 			ExecuteDataQueryRequest: a.TableExecuteDataQueryRequest(),
 			IgnoreTruncated:         s.session.config.IgnoreTruncated(),
+			StrictNullScan:          s.session.config.StrictNullScan(),
 		}
 		callOptions []grpc.CallOption
 	)
@@ -47,7 +48,7 @@ func (s *statement) Execute(
 	request.Parameters = parameters.ToYDB(a)
 	request.Query = s.query.toYDB(a)
 	request.QueryCachePolicy = a.TableQueryCachePolicy()
-	request.QueryCachePolicy.KeepInCache = len(request.Parameters) > 0
+	request.QueryCachePolicy.KeepInCache = s.session.config.KeepInCache() || len(request.Parameters) > 0
 	request.OperationParams = operation.Params(ctx,
 		s.session.config.OperationTimeout(),
 		s.session.config.OperationCancelAfter(),
@@ -83,10 +84,15 @@ func (s *statement) execute(
 ) {
 	res, err := s.session.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
 	if err != nil {
+		if s.session.statements != nil && xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			// prepared query was evicted on server-side
+			s.session.statements.remove(s.Text())
+		}
+
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	return s.session.executeQueryResult(res, txControl, request.IgnoreTruncated)
+	return s.session.executeQueryResult(res, txControl, request.IgnoreTruncated, request.StrictNullScan)
 }
 
 func (s *statement) NumInput() int {
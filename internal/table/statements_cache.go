@@ -0,0 +1,75 @@
+package table
+
+import (
+	"container/list"
+	"sync"
+)
+
+// statementsCache is a LRU cache of prepared statements of session keyed by query text
+type statementsCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // of *statement, most recently used at front
+	index    map[string]*list.Element
+}
+
+func newStatementsCache(capacity int) *statementsCache {
+	return &statementsCache{
+		capacity: capacity,
+		order:    list.New(),
+		index:    make(map[string]*list.Element, capacity),
+	}
+}
+
+func (c *statementsCache) get(query string) (*statement, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, has := c.index[query]
+	if !has {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+
+	return el.Value.(*statement), true //nolint:forcetypeassert
+}
+
+func (c *statementsCache) put(stmt *statement) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	query := stmt.Text()
+	if el, has := c.index[query]; has {
+		el.Value = stmt
+		c.order.MoveToFront(el)
+
+		return
+	}
+	c.index[query] = c.order.PushFront(stmt)
+	for c.order.Len() > c.capacity {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.index, last.Value.(*statement).Text()) //nolint:forcetypeassert
+	}
+}
+
+func (c *statementsCache) remove(query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, has := c.index[query]; has {
+		c.order.Remove(el)
+		delete(c.index, query)
+	}
+}
+
+func (c *statementsCache) len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
@@ -0,0 +1,94 @@
+package table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestStatementsCache(t *testing.T) {
+	c := newStatementsCache(2)
+	a := &statement{query: queryPrepared("1", "a")}
+	b := &statement{query: queryPrepared("2", "b")}
+	d := &statement{query: queryPrepared("3", "c")}
+	c.put(a)
+	c.put(b)
+	stmt, has := c.get("a")
+	require.True(t, has)
+	require.Equal(t, a, stmt)
+	c.put(d) // evicts least recently used "b"
+	require.Equal(t, 2, c.len())
+	_, has = c.get("b")
+	require.False(t, has)
+	_, has = c.get("a")
+	require.True(t, has)
+	c.remove("a")
+	_, has = c.get("a")
+	require.False(t, has)
+	require.Equal(t, 1, c.len())
+	require.Equal(t, 0, (*statementsCache)(nil).len())
+}
+
+type prepareDataQueryMock struct {
+	Ydb_Table_V1.TableServiceClient
+
+	calls int
+}
+
+func (mock *prepareDataQueryMock) PrepareDataQuery(
+	_ context.Context, in *Ydb_Table.PrepareDataQueryRequest, opts ...grpc.CallOption,
+) (*Ydb_Table.PrepareDataQueryResponse, error) {
+	mock.calls++
+	result, err := anypb.New(&Ydb_Table.PrepareQueryResult{
+		QueryId: in.GetYqlText(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ydb_Table.PrepareDataQueryResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: result,
+		},
+	}, nil
+}
+
+func TestSessionPrepareCache(t *testing.T) {
+	ctx := xtest.Context(t)
+	var hits []bool
+	mock := &prepareDataQueryMock{}
+	s := &session{
+		id:           "test",
+		tableService: mock,
+		config: config.New(config.WithTrace(&trace.Table{
+			OnSessionQueryPrepare: func(trace.TablePrepareDataQueryStartInfo) func(trace.TablePrepareDataQueryDoneInfo) {
+				return func(info trace.TablePrepareDataQueryDoneInfo) {
+					hits = append(hits, info.CacheHit)
+				}
+			},
+		})),
+		statements: newStatementsCache(10),
+	}
+	first, err := s.Prepare(ctx, "SELECT 1")
+	require.NoError(t, err)
+	second, err := s.Prepare(ctx, "SELECT 1")
+	require.NoError(t, err)
+	require.Same(t, first, second)
+	_, err = s.Prepare(ctx, "SELECT 2")
+	require.NoError(t, err)
+	require.Equal(t, 2, mock.calls)
+	require.Equal(t, []bool{false, true, false}, hits)
+}
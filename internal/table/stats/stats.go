@@ -0,0 +1,13 @@
+package stats
+
+type Stats struct {
+	Limit            int
+	Index            int
+	Idle             int
+	InUse            int
+	WaitQ            int
+	CreateInProgress int
+	CreateErrors     uint64
+	PendingDeletes   int            // number of broken sessions waiting for background deletion
+	Nodes            map[uint32]int // number of sessions by node ID
+}
@@ -188,6 +188,9 @@ func (tx *transaction) CommitTx(
 			nil,
 			result.GetQueryStats(),
 			scanner.WithIgnoreTruncated(tx.s.config.IgnoreTruncated()),
+			scanner.WithStrictNull(tx.s.config.StrictNullScan()),
+			scanner.WithCopyBytes(tx.s.config.CopyBytesScan()),
+			scanner.WithColumnMatching(tx.s.config.ColumnMatching()),
 		), nil
 	}
 }
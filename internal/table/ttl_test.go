@@ -0,0 +1,28 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func TestTimeToLiveSettingsRoundTrip(t *testing.T) {
+	for _, settings := range []options.TimeToLiveSettings{
+		options.NewTTLSettings().ColumnDateType("expire_at").ExpireAfter(time.Hour),
+		options.NewTTLSettings().ColumnSeconds("expire_at").ExpireAfter(time.Minute),
+		options.NewTTLSettings().ColumnMilliseconds("expire_at").ExpireAfter(time.Second),
+		options.NewTTLSettings().ColumnMicroseconds("expire_at").ExpireAfter(2 * time.Hour),
+		options.NewTTLSettings().ColumnNanoseconds("expire_at").ExpireAfter(24 * time.Hour),
+	} {
+		t.Run("", func(t *testing.T) {
+			expected := settings
+			require.Equal(t, &expected, NewTimeToLiveSettings(expected.ToYDB()))
+		})
+	}
+	t.Run("nil", func(t *testing.T) {
+		require.Nil(t, NewTimeToLiveSettings(nil))
+	})
+}
@@ -66,6 +66,9 @@ func TypeFromYDB(x *Ydb.Type) Type {
 			panic("ydb: unknown variant type")
 		}
 
+	case *Ydb.Type_TaggedType:
+		return NewTagged(v.TaggedType.GetTag(), TypeFromYDB(v.TaggedType.GetType()))
+
 	case *Ydb.Type_VoidType:
 		return NewVoid()
 
@@ -73,7 +76,7 @@ func TypeFromYDB(x *Ydb.Type) Type {
 		return NewNull()
 
 	case *Ydb.Type_PgType:
-		return &PgType{
+		return PgType{
 			OID: x.GetPgType().GetOid(),
 		}
 
@@ -710,6 +713,54 @@ func StructFields(ms []*Ydb.StructMember) []StructField {
 	return fs
 }
 
+type Tagged struct {
+	tag       string
+	innerType Type
+}
+
+func (v *Tagged) Tag() string {
+	return v.tag
+}
+
+func (v *Tagged) InnerType() Type {
+	return v.innerType
+}
+
+func (v *Tagged) String() string {
+	return v.Yql()
+}
+
+func (v *Tagged) Yql() string {
+	return "Tagged<" + v.innerType.Yql() + ",'" + v.tag + "'>"
+}
+
+func (v *Tagged) equalsTo(rhs Type) bool {
+	vv, ok := rhs.(*Tagged)
+	if !ok {
+		return false
+	}
+
+	return v.tag == vv.tag && v.innerType.equalsTo(vv.innerType)
+}
+
+func (v *Tagged) ToYDB(a *allocator.Allocator) *Ydb.Type {
+	//nolint:godox
+	// TODO: make allocator
+	return &Ydb.Type{Type: &Ydb.Type_TaggedType{
+		TaggedType: &Ydb.TaggedType{
+			Tag:  v.tag,
+			Type: v.innerType.ToYDB(a),
+		},
+	}}
+}
+
+func NewTagged(tag string, t Type) *Tagged {
+	return &Tagged{
+		tag:       tag,
+		innerType: t,
+	}
+}
+
 type Tuple struct {
 	innerTypes []Type
 }
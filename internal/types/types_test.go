@@ -225,6 +225,10 @@ func TestTypeToString(t *testing.T) {
 			t: NewDecimal(22, 9),
 			s: "Decimal(22,9)",
 		},
+		{
+			t: NewTagged("tag", Uint64),
+			s: "Tagged<Uint64,'tag'>",
+		},
 		{
 			t: NewDict(Text, Timestamp),
 			s: "Dict<Utf8,Timestamp>",
@@ -1,5 +1,93 @@
 package value
 
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const structTagName = "ydb"
+
+// StructFieldName returns column name and optional YDB type name of struct field from
+// `ydb:"name[,Type]"` tag. Field name is used if tag or name in tag is empty.
+// ok is false for unexported fields and fields with `ydb:"-"` tag.
+func StructFieldName(f reflect.StructField) (name, typeName string, ok bool) {
+	if !f.IsExported() {
+		return "", "", false
+	}
+	name = f.Name
+	if tag, has := f.Tag.Lookup(structTagName); has {
+		if tag == "-" {
+			return "", "", false
+		}
+		tagName, tagType, _ := strings.Cut(tag, ",")
+		if tagName != "" {
+			name = tagName
+		}
+		typeName = tagType
+	}
+
+	return name, typeName, true
+}
+
 func CastTo(v Value, dst interface{}) error {
 	return v.castTo(dst)
 }
+
+// castItemsToSlice casts items of container value into slice destination
+func castItemsToSlice(v Value, items []Value, dst interface{}) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Slice {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%s' to '%T' destination",
+			ErrCannotCast, v.Type().Yql(), dst,
+		))
+	}
+
+	slice := reflect.MakeSlice(ptr.Elem().Type(), len(items), len(items))
+	for i := range items {
+		if err := items[i].castTo(slice.Index(i).Addr().Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	ptr.Elem().Set(slice)
+
+	return nil
+}
+
+// castFieldsToStruct casts struct value fields into fields of Go struct destination.
+// Go struct fields are matched by `ydb:"name"` tag or by field name if tag is not defined.
+// Destination fields which are not present in value are left untouched.
+func castFieldsToStruct(v *structValue, dst interface{}) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Struct {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%s' to '%T' destination",
+			ErrCannotCast, v.Type().Yql(), dst,
+		))
+	}
+
+	fields := make(map[string]Value, len(v.fields))
+	for i := range v.fields {
+		fields[v.fields[i].Name] = v.fields[i].V
+	}
+
+	rv := ptr.Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		name, _, ok := StructFieldName(rv.Type().Field(i))
+		if !ok {
+			continue
+		}
+		fieldValue, has := fields[name]
+		if !has {
+			continue
+		}
+		if err := fieldValue.castTo(rv.Field(i).Addr().Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("field %q: %w", name, err))
+		}
+	}
+
+	return nil
+}
@@ -1,4 +1,4 @@
-package testutil
+package value
 
 import (
 	"errors"
@@ -9,14 +9,13 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 )
 
 func TestUnwrapOptionalValue(t *testing.T) {
 	a := allocator.New()
 	defer a.Free()
-	v := value.OptionalValue(value.OptionalValue(value.TextValue("a")))
-	val := unwrapTypedValue(value.ToYDB(v, a))
+	v := OptionalValue(OptionalValue(TextValue("a")))
+	val := unwrapTypedValue(ToYDB(v, a))
 	typeID := val.GetType().GetTypeId()
 	if typeID != Ydb.Type_UTF8 {
 		t.Errorf("Types are different: expected %d, actual %d", Ydb.Type_UTF8, typeID)
@@ -31,8 +30,8 @@ func TestUnwrapOptionalValue(t *testing.T) {
 func TestUnwrapPrimitiveValue(t *testing.T) {
 	a := allocator.New()
 	defer a.Free()
-	v := value.TextValue("a")
-	val := unwrapTypedValue(value.ToYDB(v, a))
+	v := TextValue("a")
+	val := unwrapTypedValue(ToYDB(v, a))
 	typeID := val.GetType().GetTypeId()
 	if typeID != Ydb.Type_UTF8 {
 		t.Errorf("Types are different: expected %d, actual %d", Ydb.Type_UTF8, typeID)
@@ -47,8 +46,8 @@ func TestUnwrapPrimitiveValue(t *testing.T) {
 func TestUnwrapNullValue(t *testing.T) {
 	a := allocator.New()
 	defer a.Free()
-	v := value.NullValue(types.Text)
-	val := unwrapTypedValue(value.ToYDB(v, a))
+	v := NullValue(types.Text)
+	val := unwrapTypedValue(ToYDB(v, a))
 	typeID := val.GetType().GetTypeId()
 	if typeID != Ydb.Type_UTF8 {
 		t.Errorf("Types are different: expected %d, actual %d", Ydb.Type_UTF8, typeID)
@@ -60,8 +59,8 @@ func TestUnwrapNullValue(t *testing.T) {
 }
 
 func TestUint8(t *testing.T) {
-	l := value.Uint8Value(byte(1))
-	r := value.Uint8Value(byte(10))
+	l := Uint8Value(byte(1))
+	r := Uint8Value(byte(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -76,8 +75,8 @@ func TestUint8(t *testing.T) {
 }
 
 func TestInt8(t *testing.T) {
-	l := value.Int8Value(int8(1))
-	r := value.Int8Value(int8(10))
+	l := Int8Value(int8(1))
+	r := Int8Value(int8(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -92,8 +91,8 @@ func TestInt8(t *testing.T) {
 }
 
 func TestTimestamp(t *testing.T) {
-	l := value.TimestampValue(1)
-	r := value.TimestampValue(10)
+	l := TimestampValue(1)
+	r := TimestampValue(10)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -108,8 +107,8 @@ func TestTimestamp(t *testing.T) {
 }
 
 func TestDateTime(t *testing.T) {
-	l := value.DatetimeValue(1)
-	r := value.DatetimeValue(10)
+	l := DatetimeValue(1)
+	r := DatetimeValue(10)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -124,8 +123,8 @@ func TestDateTime(t *testing.T) {
 }
 
 func TestUint64(t *testing.T) {
-	l := value.Uint64Value(uint64(1))
-	r := value.Uint64Value(uint64(10))
+	l := Uint64Value(uint64(1))
+	r := Uint64Value(uint64(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -140,8 +139,8 @@ func TestUint64(t *testing.T) {
 }
 
 func TestInt64(t *testing.T) {
-	l := value.Int64Value(int64(1))
-	r := value.Int64Value(int64(10))
+	l := Int64Value(int64(1))
+	r := Int64Value(int64(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -156,8 +155,8 @@ func TestInt64(t *testing.T) {
 }
 
 func TestDouble(t *testing.T) {
-	l := value.DoubleValue(1.0)
-	r := value.DoubleValue(2.0)
+	l := DoubleValue(1.0)
+	r := DoubleValue(2.0)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -172,8 +171,8 @@ func TestDouble(t *testing.T) {
 }
 
 func TestFloat(t *testing.T) {
-	l := value.FloatValue(1.0)
-	r := value.FloatValue(2.0)
+	l := FloatValue(1.0)
+	r := FloatValue(2.0)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -188,8 +187,8 @@ func TestFloat(t *testing.T) {
 }
 
 func TestUTF8(t *testing.T) {
-	l := value.TextValue("abc")
-	r := value.TextValue("abx")
+	l := TextValue("abc")
+	r := TextValue("abx")
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -204,8 +203,8 @@ func TestUTF8(t *testing.T) {
 }
 
 func TestOptionalUTF8(t *testing.T) {
-	l := value.OptionalValue(value.OptionalValue(value.TextValue("abc")))
-	r := value.TextValue("abx")
+	l := OptionalValue(OptionalValue(TextValue("abc")))
+	r := TextValue("abx")
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -220,8 +219,8 @@ func TestOptionalUTF8(t *testing.T) {
 }
 
 func TestBytes(t *testing.T) {
-	l := value.BytesValue([]byte{1, 2, 3})
-	r := value.BytesValue([]byte{1, 2, 5})
+	l := BytesValue([]byte{1, 2, 3})
+	r := BytesValue([]byte{1, 2, 5})
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -236,8 +235,8 @@ func TestBytes(t *testing.T) {
 }
 
 func TestNull(t *testing.T) {
-	l := value.NullValue(types.Text)
-	r := value.TextValue("abc")
+	l := NullValue(types.Text)
+	r := TextValue("abc")
 
 	c, err := Compare(l, r)
 	requireNoError(t, err)
@@ -253,10 +252,10 @@ func TestNull(t *testing.T) {
 }
 
 func TestTuple(t *testing.T) {
-	withNull := value.TupleValue(value.Uint64Value(1), value.NullValue(types.Text))
-	least := value.TupleValue(value.Uint64Value(1), value.TextValue("abc"))
-	medium := value.TupleValue(value.Uint64Value(1), value.TextValue("def"))
-	largest := value.TupleValue(value.Uint64Value(2), value.TextValue("abc"))
+	withNull := TupleValue(Uint64Value(1), NullValue(types.Text))
+	least := TupleValue(Uint64Value(1), TextValue("abc"))
+	medium := TupleValue(Uint64Value(1), TextValue("def"))
+	largest := TupleValue(Uint64Value(2), TextValue("abc"))
 
 	c, err := Compare(least, medium)
 	requireNoError(t, err)
@@ -280,9 +279,9 @@ func TestTuple(t *testing.T) {
 }
 
 func TestList(t *testing.T) {
-	least := value.ListValue(value.Uint64Value(1), value.Uint64Value(1))
-	medium := value.ListValue(value.Uint64Value(1), value.Uint64Value(2))
-	largest := value.ListValue(value.Uint64Value(2), value.Uint64Value(1))
+	least := ListValue(Uint64Value(1), Uint64Value(1))
+	medium := ListValue(Uint64Value(1), Uint64Value(2))
+	largest := ListValue(Uint64Value(2), Uint64Value(1))
 
 	c, err := Compare(least, medium)
 	requireNoError(t, err)
@@ -298,8 +297,8 @@ func TestList(t *testing.T) {
 }
 
 func TestDyNumber(t *testing.T) {
-	l := value.DyNumberValue("2")
-	r := value.DyNumberValue("12")
+	l := DyNumberValue("2")
+	r := DyNumberValue("12")
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -314,9 +313,9 @@ func TestDyNumber(t *testing.T) {
 }
 
 func TestUUID(t *testing.T) {
-	l := value.UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
-	r := value.UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
-	g := value.UUIDValue([16]byte{100, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
+	l := UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	r := UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
+	g := UUIDValue([16]byte{100, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -335,8 +334,8 @@ func TestUUID(t *testing.T) {
 }
 
 func TestIncompatiblePrimitives(t *testing.T) {
-	l := value.Uint64Value(1)
-	r := value.TimestampValue(2)
+	l := Uint64Value(1)
+	r := TimestampValue(2)
 	_, err := Compare(l, r)
 	if err == nil {
 		t.Errorf("WithStackTrace expected")
@@ -347,8 +346,8 @@ func TestIncompatiblePrimitives(t *testing.T) {
 }
 
 func TestIncompatibleTuples(t *testing.T) {
-	l := value.TupleValue(value.Uint64Value(1), value.TextValue("abc"))
-	r := value.TupleValue(value.Uint64Value(1), value.BytesValue([]byte("abc")))
+	l := TupleValue(Uint64Value(1), TextValue("abc"))
+	r := TupleValue(Uint64Value(1), BytesValue([]byte("abc")))
 	_, err := Compare(l, r)
 	if err == nil {
 		t.Error("WithStackTrace expected")
@@ -358,8 +357,8 @@ func TestIncompatibleTuples(t *testing.T) {
 }
 
 func TestTupleOfDifferentLength(t *testing.T) {
-	l := value.TupleValue(value.Uint64Value(1), value.TextValue("abc"))
-	r := value.TupleValue(value.Uint64Value(1), value.TextValue("abc"), value.TextValue("def"))
+	l := TupleValue(Uint64Value(1), TextValue("abc"))
+	r := TupleValue(Uint64Value(1), TextValue("abc"), TextValue("def"))
 
 	cmp, err := Compare(l, r)
 	requireNoError(t, err)
@@ -371,8 +370,8 @@ func TestTupleOfDifferentLength(t *testing.T) {
 }
 
 func TestTupleInTuple(t *testing.T) {
-	l := value.TupleValue(value.Uint64Value(1), value.TupleValue(value.TextValue("abc"), value.BytesValue([]byte("xyz"))))
-	r := value.TupleValue(value.Uint64Value(1), value.TupleValue(value.TextValue("def"), value.BytesValue([]byte("xyz"))))
+	l := TupleValue(Uint64Value(1), TupleValue(TextValue("abc"), BytesValue([]byte("xyz"))))
+	r := TupleValue(Uint64Value(1), TupleValue(TextValue("def"), BytesValue([]byte("xyz"))))
 
 	cmp, err := Compare(l, r)
 	requireNoError(t, err)
@@ -388,18 +387,18 @@ func TestTupleInTuple(t *testing.T) {
 }
 
 func TestListInList(t *testing.T) {
-	l := value.ListValue(
-		value.ListValue(
-			value.TextValue("abc"), value.TextValue("def"),
-		), value.ListValue(
-			value.TextValue("uvw"), value.TextValue("xyz"),
+	l := ListValue(
+		ListValue(
+			TextValue("abc"), TextValue("def"),
+		), ListValue(
+			TextValue("uvw"), TextValue("xyz"),
 		),
 	)
-	r := value.ListValue(
-		value.ListValue(
-			value.TextValue("abc"), value.TextValue("deg"),
-		), value.ListValue(
-			value.TextValue("uvw"), value.TextValue("xyz"),
+	r := ListValue(
+		ListValue(
+			TextValue("abc"), TextValue("deg"),
+		), ListValue(
+			TextValue("uvw"), TextValue("xyz"),
 		),
 	)
 
@@ -416,6 +415,30 @@ func TestListInList(t *testing.T) {
 	requireEqualValues(t, 0, cmp)
 }
 
+func TestStruct(t *testing.T) {
+	l := StructValue(StructValueField{"a", Int32Value(1)}, StructValueField{"b", TextValue("abc")})
+	r := StructValue(StructValueField{"a", Int32Value(1)}, StructValueField{"b", TextValue("def")})
+
+	cmp, err := Compare(l, r)
+	requireNoError(t, err)
+	requireEqualValues(t, -1, cmp)
+
+	cmp, err = Compare(l, l)
+	requireNoError(t, err)
+	requireEqualValues(t, 0, cmp)
+}
+
+func TestStructsWithDifferentMemberNames(t *testing.T) {
+	l := StructValue(StructValueField{"a", Int32Value(1)})
+	r := StructValue(StructValueField{"b", Int32Value(1)})
+	_, err := Compare(l, r)
+	if err == nil {
+		t.Error("WithStackTrace expected")
+	} else if !errors.Is(err, ErrNotComparable) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
 func requireNoError(t *testing.T, err error) {
 	t.Helper()
 	if err != nil {
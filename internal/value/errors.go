@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrCannotCast                   = errors.New("cannot cast")
 	errDestinationTypeIsNotAPointer = errors.New("destination type is not a pointer")
+	errIntervalOverflow             = errors.New("interval overflows time.Duration")
 )
@@ -0,0 +1,182 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+)
+
+// Native returns Go representation of value which is suitable for encoding with encoding/json
+//
+// NULL is nil and optional values are unwrapped. Numbers, Bool and Interval are Go numbers and bool,
+// NaN and infinite Float and Double values are "NaN", "Infinity" and "-Infinity" strings (not supported
+// by JSON as numbers). Text, DyNumber, Decimal, UUID and Pg values are strings, String and Yson are
+// []byte (base64 in JSON, because values may be not valid UTF-8), Json and JsonDocument are
+// json.RawMessage and date and time values are time.Time (in UTC for values without time zone).
+// List, Set and Tuple are []interface{}, Struct is map[string]interface{}, Dict is []interface{}
+// of {"key", "value"} maps, Variant and Tagged are represented by inner values, Void is nil.
+//
+//nolint:gocyclo,funlen
+func Native(v Value) interface{} {
+	switch vv := v.(type) {
+	case nil, voidValue:
+		return nil
+	case *optionalValue:
+		return Native(vv.value)
+	case *taggedValue:
+		return Native(vv.value)
+	case *variantValue:
+		return Native(vv.value)
+	case boolValue:
+		return bool(vv)
+	case int8Value:
+		return int8(vv)
+	case int16Value:
+		return int16(vv)
+	case int32Value:
+		return int32(vv)
+	case int64Value:
+		return int64(vv)
+	case uint8Value:
+		return uint8(vv)
+	case uint16Value:
+		return uint16(vv)
+	case uint32Value:
+		return uint32(vv)
+	case uint64Value:
+		return uint64(vv)
+	case *floatValue:
+		if f := nativeNonFinite(float64(vv.value)); f != nil {
+			return f
+		}
+
+		return vv.value
+	case *doubleValue:
+		if f := nativeNonFinite(vv.value); f != nil {
+			return f
+		}
+
+		return vv.value
+	case intervalValue:
+		return IntervalToDuration(int64(vv))
+	case dateValue:
+		return DateToTime(uint32(vv)).UTC()
+	case datetimeValue:
+		return DatetimeToTime(uint32(vv)).UTC()
+	case timestampValue:
+		return TimestampToTime(uint64(vv)).UTC()
+	case tzDateValue, tzDatetimeValue, tzTimestampValue:
+		var t time.Time
+		if err := v.castTo(&t); err != nil {
+			return v.Yql()
+		}
+
+		return t
+	case textValue:
+		return string(vv)
+	case bytesValue:
+		return []byte(vv)
+	case ysonValue:
+		return []byte(vv)
+	case dyNumberValue:
+		return string(vv)
+	case jsonValue:
+		return json.RawMessage(vv)
+	case jsonDocumentValue:
+		return json.RawMessage(vv)
+	case *uuidValue:
+		return uuid.UUID(vv.value).String()
+	case *decimalValue:
+		d := decimal.Decimal{Bytes: vv.value, Precision: vv.innerType.Precision(), Scale: vv.innerType.Scale()}
+
+		return d.String()
+	case pgValue:
+		return vv.val
+	case *listValue:
+		return nativeItems(vv.items)
+	case *setValue:
+		return nativeItems(vv.items)
+	case *tupleValue:
+		return nativeItems(vv.items)
+	case *structValue:
+		fields := make(map[string]interface{}, len(vv.fields))
+		for _, f := range vv.fields {
+			fields[f.Name] = Native(f.V)
+		}
+
+		return fields
+	case *dictValue:
+		pairs := make([]interface{}, 0, len(vv.values))
+		for _, f := range vv.values {
+			pairs = append(pairs, map[string]interface{}{
+				"key":   Native(f.K),
+				"value": Native(f.V),
+			})
+		}
+
+		return pairs
+	default:
+		return v.Yql()
+	}
+}
+
+// nativeNonFinite returns string representation of NaN and infinite values or nil for finite values
+func nativeNonFinite(f float64) interface{} {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	default:
+		return nil
+	}
+}
+
+func nativeItems(items []Value) []interface{} {
+	values := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		values = append(values, Native(item))
+	}
+
+	return values
+}
+
+// NativeString returns text representation of value: strings and bytes are returned as is, other values
+// are formatted from Native representation, NULL is empty string
+func NativeString(v Value) string {
+	switch vv := Native(v).(type) {
+	case nil:
+		return ""
+	case string:
+		return vv
+	case []byte:
+		return string(vv)
+	case json.RawMessage:
+		return string(vv)
+	case time.Time:
+		return vv.Format(time.RFC3339Nano)
+	case bool:
+		return strconv.FormatBool(vv)
+	case float32:
+		return strconv.FormatFloat(float64(vv), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(vv, 'g', -1, 64)
+	default:
+		if s, ok := vv.(interface{ String() string }); ok {
+			return s.String()
+		}
+		b, err := json.Marshal(vv)
+		if err != nil {
+			return v.Yql()
+		}
+
+		return string(b)
+	}
+}
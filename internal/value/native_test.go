@@ -0,0 +1,61 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestNative(t *testing.T) {
+	ts := time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC)
+	for _, tt := range []struct {
+		name string
+		v    Value
+		exp  interface{}
+		str  string
+	}{
+		{"Null", NullValue(types.Text), nil, ""},
+		{"Optional", OptionalValue(Int32Value(1)), int32(1), "1"},
+		{"Bool", BoolValue(true), true, "true"},
+		{"Double", DoubleValue(1.5), 1.5, "1.5"},
+		{"Text", TextValue("a"), "a", "a"},
+		{"NaN", DoubleValue(math.NaN()), "NaN", "NaN"},
+		{"Inf", FloatValue(float32(math.Inf(1))), "Infinity", "Infinity"},
+		{"NegInf", DoubleValue(math.Inf(-1)), "-Infinity", "-Infinity"},
+		{"Bytes", BytesValue([]byte("b")), []byte("b"), "b"},
+		{"Yson", YSONValue([]byte("\xff")), []byte("\xff"), "\xff"},
+		{"JSON", JSONValue(`{"a":1}`), json.RawMessage(`{"a":1}`), `{"a":1}`},
+		{"Timestamp", TimestampValueFromTime(ts), ts, "2024-01-02T03:04:05.000006Z"},
+		{"Interval", IntervalValueFromDuration(time.Second), time.Second, "1s"},
+		{
+			"UUID",
+			UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+			"01020304-0506-0708-090a-0b0c0d0e0f10",
+			"01020304-0506-0708-090a-0b0c0d0e0f10",
+		},
+		{"List", ListValue(Uint64Value(1), Uint64Value(2)), []interface{}{uint64(1), uint64(2)}, "[1,2]"},
+		{
+			"Struct",
+			StructValue(StructValueField{Name: "a", V: TextValue("b")}),
+			map[string]interface{}{"a": "b"},
+			`{"a":"b"}`,
+		},
+		{
+			"Dict",
+			DictValue(DictValueField{K: TextValue("a"), V: Uint8Value(1)}),
+			[]interface{}{map[string]interface{}{"key": "a", "value": uint8(1)}},
+			`[{"key":"a","value":1}]`,
+		},
+		{"Tagged", TaggedValue("tag", TextValue("a")), "a", "a"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp, Native(tt.v))
+			require.Equal(t, tt.str, NativeString(tt.v))
+		})
+	}
+}
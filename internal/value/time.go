@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -31,11 +32,27 @@ const (
 var epoch = time.Unix(0, 0)
 
 // IntervalToDuration returns time.Duration from given microseconds
+//
+// Result overflows silently if interval is out of time.Duration range (about ±292 years),
+// use IntervalToDurationChecked for checked conversion.
 func IntervalToDuration(n int64) time.Duration {
 	return time.Duration(n) * time.Microsecond
 }
 
+// IntervalToDurationChecked returns time.Duration from given microseconds or error
+// if interval is out of time.Duration range (about ±292 years)
+func IntervalToDurationChecked(n int64) (time.Duration, error) {
+	if n > int64(math.MaxInt64/time.Microsecond) || n < int64(math.MinInt64/time.Microsecond) {
+		return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %dus", errIntervalOverflow, n))
+	}
+
+	return IntervalToDuration(n), nil
+}
+
 // durationToMicroseconds returns microseconds from given time.Duration
+//
+// Interval precision is one microsecond, so nanoseconds are truncated towards zero
+// (e.g. 1999ns and -1999ns are converted to 1us and -1us). Any time.Duration fits into Interval.
 func durationToMicroseconds(d time.Duration) int64 {
 	return int64(d / time.Microsecond)
 }
@@ -65,7 +82,7 @@ func TzDateToTime(s string) (t time.Time, err error) {
 	if len(ss) != 2 { //nolint:gomnd
 		return t, xerrors.WithStackTrace(fmt.Errorf("not found timezone location in '%s'", s))
 	}
-	location, err := time.LoadLocation(ss[1])
+	location, err := tzLocation(ss[1])
 	if err != nil {
 		return t, xerrors.WithStackTrace(err)
 	}
@@ -82,7 +99,7 @@ func TzDatetimeToTime(s string) (t time.Time, err error) {
 	if len(ss) != 2 { //nolint:gomnd
 		return t, xerrors.WithStackTrace(fmt.Errorf("not found timezone location in '%s'", s))
 	}
-	location, err := time.LoadLocation(ss[1])
+	location, err := tzLocation(ss[1])
 	if err != nil {
 		return t, xerrors.WithStackTrace(err)
 	}
@@ -99,7 +116,7 @@ func TzTimestampToTime(s string) (t time.Time, err error) {
 	if len(ss) != 2 { //nolint:gomnd
 		return t, xerrors.WithStackTrace(fmt.Errorf("not found timezone location in '%s'", s))
 	}
-	location, err := time.LoadLocation(ss[1])
+	location, err := tzLocation(ss[1])
 	if err != nil {
 		return t, xerrors.WithStackTrace(err)
 	}
@@ -114,3 +131,49 @@ func TzTimestampToTime(s string) (t time.Time, err error) {
 
 	return t, nil
 }
+
+// LayoutTzOffset is a layout of numeric time zone offset of Tz* types for fixed zones
+const LayoutTzOffset = "-07:00"
+
+// tzLocations caches results of time.LoadLocation by name of time location
+var tzLocations sync.Map // map[string]*time.Location or error
+
+// tzLocation returns time location by IANA name or by numeric offset (e.g. +03:00)
+func tzLocation(name string) (*time.Location, error) {
+	if v, has := tzLocations.Load(name); has {
+		if location, ok := v.(*time.Location); ok {
+			return location, nil
+		}
+
+		return nil, v.(error) //nolint:forcetypeassert
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		offset, parseErr := time.Parse(LayoutTzOffset, name)
+		if parseErr != nil {
+			tzLocations.Store(name, err)
+
+			return nil, err
+		}
+		_, seconds := offset.Zone()
+		location = time.FixedZone(name, seconds)
+	}
+	tzLocations.Store(name, location)
+
+	return location, nil
+}
+
+// timeToTz formats time with layout and appends name of time location as expected by Tz* types.
+// Local and unnamed locations cannot be loaded on server side, so such times are converted to UTC.
+// Names of fixed zones (see time.FixedZone) are unknown for server, so numeric offset is used instead
+func timeToTz(t time.Time, layout string) string {
+	name := t.Location().String()
+	if t.Location() == time.Local || name == "" {
+		t, name = t.UTC(), time.UTC.String()
+	}
+	if _, err := tzLocation(name); err != nil {
+		name = t.Format(LayoutTzOffset)
+	}
+
+	return t.Format(layout) + "," + name
+}
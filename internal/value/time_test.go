@@ -1,6 +1,7 @@
 package value
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -70,3 +71,114 @@ func TestTzSomeToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestTimePrecision(t *testing.T) {
+	src := time.Date(2020, time.May, 29, 11, 22, 54, 123456789, time.UTC)
+	for _, tt := range []struct {
+		name string
+		v    Value
+		exp  time.Time
+	}{
+		{
+			"Date",
+			DateValueFromTime(src),
+			time.Date(2020, time.May, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"Datetime",
+			DatetimeValueFromTime(src),
+			time.Date(2020, time.May, 29, 11, 22, 54, 0, time.UTC),
+		},
+		{
+			"Timestamp",
+			TimestampValueFromTime(src),
+			time.Date(2020, time.May, 29, 11, 22, 54, 123456000, time.UTC),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst time.Time
+			require.NoError(t, CastTo(tt.v, &dst))
+			require.True(t, tt.exp.Equal(dst), "%v != %v", tt.exp, dst)
+		})
+	}
+	t.Run("Interval", func(t *testing.T) {
+		var dst time.Duration
+		require.NoError(t, CastTo(IntervalValueFromDuration(1234567891*time.Nanosecond), &dst))
+		require.Equal(t, 1234567*time.Microsecond, dst)
+		require.NoError(t, CastTo(IntervalValueFromDuration(-1999*time.Nanosecond), &dst))
+		require.Equal(t, -time.Microsecond, dst)
+	})
+}
+
+func TestIntervalToDurationChecked(t *testing.T) {
+	maxInterval := int64(math.MaxInt64 / time.Microsecond)
+	minInterval := int64(math.MinInt64 / time.Microsecond)
+	d, err := IntervalToDurationChecked(maxInterval)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(maxInterval)*time.Microsecond, d)
+	d, err = IntervalToDurationChecked(minInterval)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(minInterval)*time.Microsecond, d)
+	_, err = IntervalToDurationChecked(maxInterval + 1)
+	require.ErrorIs(t, err, errIntervalOverflow)
+	_, err = IntervalToDurationChecked(minInterval - 1)
+	require.ErrorIs(t, err, errIntervalOverflow)
+	var dst time.Duration
+	require.ErrorIs(t, CastTo(IntervalValue(math.MaxInt64), &dst), errIntervalOverflow)
+}
+
+func TestTzValueFromTime(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	src := time.Date(2020, time.May, 29, 11, 22, 54, 123456789, location)
+	for _, tt := range []struct {
+		name string
+		v    Value
+		s    string
+		exp  time.Time
+	}{
+		{
+			"TzDate",
+			TzDateValueFromTime(src),
+			"2020-05-29,Europe/Berlin",
+			time.Date(2020, time.May, 29, 0, 0, 0, 0, location),
+		},
+		{
+			"TzDatetime",
+			TzDatetimeValueFromTime(src),
+			"2020-05-29T11:22:54,Europe/Berlin",
+			time.Date(2020, time.May, 29, 11, 22, 54, 0, location),
+		},
+		{
+			"TzTimestamp",
+			TzTimestampValueFromTime(src),
+			"2020-05-29T11:22:54.123456,Europe/Berlin",
+			time.Date(2020, time.May, 29, 11, 22, 54, 123456000, location),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var s string
+			require.NoError(t, CastTo(tt.v, &s))
+			require.Equal(t, tt.s, s)
+			var dst time.Time
+			require.NoError(t, CastTo(tt.v, &dst))
+			require.True(t, tt.exp.Equal(dst), "%v != %v", tt.exp, dst)
+			require.Equal(t, location.String(), dst.Location().String())
+		})
+	}
+	t.Run("Local", func(t *testing.T) {
+		var s string
+		require.NoError(t, CastTo(TzDatetimeValueFromTime(time.Unix(0, 0).Local()), &s))
+		require.Equal(t, "1970-01-01T00:00:00,UTC", s)
+	})
+	t.Run("FixedZone", func(t *testing.T) {
+		src := time.Date(2020, time.May, 29, 11, 22, 54, 0, time.FixedZone("UTC+3", 3*60*60))
+		v := TzDatetimeValueFromTime(src)
+		var s string
+		require.NoError(t, CastTo(v, &s))
+		require.Equal(t, "2020-05-29T11:22:54,+03:00", s)
+		var dst time.Time
+		require.NoError(t, CastTo(v, &dst))
+		require.True(t, src.Equal(dst), "%v != %v", src, dst)
+	})
+}
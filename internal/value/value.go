@@ -301,13 +301,18 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 			ttt.Tuple,
 		), nil
 
-	case *types.PgType:
-		return &pgValue{
-			t: types.PgType{
-				OID: ttt.OID,
-			},
-			val: v.GetTextValue(),
-		}, nil
+	case *types.Tagged:
+		a := allocator.New()
+		defer a.Free()
+
+		return TaggedValue(ttt.Tag(), FromYDB(ttt.InnerType().ToYDB(a), v)), nil
+
+	case types.PgType:
+		if x, ok := v.GetValue().(*Ydb.Value_BytesValue); ok {
+			return PgBinaryValue(ttt.OID, x.BytesValue), nil
+		}
+
+		return PgValue(ttt.OID, v.GetTextValue()), nil
 
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("uncovered type: %T", ttt))
@@ -574,10 +579,29 @@ func (v *dictValue) DictValues() map[Value]Value {
 }
 
 func (v *dictValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w '%+v' to '%T' destination",
-		ErrCannotCast, v, dst,
-	))
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Map {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%+v' to '%T' destination",
+			ErrCannotCast, v, dst,
+		))
+	}
+
+	m := reflect.MakeMapWithSize(ptr.Elem().Type(), len(v.values))
+	for i := range v.values {
+		k := reflect.New(m.Type().Key())
+		if err := v.values[i].K.castTo(k.Interface()); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		vv := reflect.New(m.Type().Elem())
+		if err := v.values[i].V.castTo(vv.Interface()); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		m.SetMapIndex(k.Elem(), vv.Elem())
+	}
+	ptr.Elem().Set(m)
+
+	return nil
 }
 
 func (v *dictValue) Yql() string {
@@ -1029,7 +1053,11 @@ type intervalValue int64
 func (v intervalValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
 	case *time.Duration:
-		*vv = IntervalToDuration(int64(v))
+		d, err := IntervalToDurationChecked(int64(v))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		*vv = d
 
 		return nil
 	case *int64:
@@ -1203,10 +1231,7 @@ func (v *listValue) ListItems() []Value {
 }
 
 func (v *listValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w '%+v' (type '%s') to '%T' destination",
-		ErrCannotCast, v, v.Type().Yql(), dst,
-	))
+	return castItemsToSlice(v, v.items, dst)
 }
 
 func (v *listValue) Yql() string {
@@ -1260,13 +1285,27 @@ func ListValue(items ...Value) *listValue {
 type pgValue struct {
 	t   types.PgType
 	val string
+
+	// binary is true for binary representation of value (bytes_value in protocol)
+	binary bool
 }
 
 func (v pgValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w  PgType to '%T' destination",
-		ErrCannotCast, dst,
-	))
+	switch vv := dst.(type) {
+	case *string:
+		*vv = v.val
+
+		return nil
+	case *[]byte:
+		*vv = []byte(v.val)
+
+		return nil
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%+v' (type '%s') to '%T' destination",
+			ErrCannotCast, v, v.Type().Yql(), vv,
+		))
+	}
 }
 
 func (v pgValue) Type() types.Type {
@@ -1276,6 +1315,14 @@ func (v pgValue) Type() types.Type {
 func (v pgValue) toYDB(_ *allocator.Allocator) *Ydb.Value {
 	//nolint:godox
 	// TODO: make allocator
+	if v.binary {
+		return &Ydb.Value{
+			Value: &Ydb.Value_BytesValue{
+				BytesValue: []byte(v.val),
+			},
+		}
+	}
+
 	return &Ydb.Value{
 		Value: &Ydb.Value_TextValue{
 			TextValue: v.val,
@@ -1296,10 +1343,7 @@ type setValue struct {
 }
 
 func (v *setValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w '%+v' to '%T' destination",
-		ErrCannotCast, v, dst,
-	))
+	return castItemsToSlice(v, v.items, dst)
 }
 
 func (v *setValue) Yql() string {
@@ -1336,6 +1380,7 @@ func (v *setValue) toYDB(a *allocator.Allocator) *Ydb.Value {
 	return vvv
 }
 
+// PgValue makes value of PostgreSQL type with given OID from text representation
 func PgValue(oid uint32, val string) pgValue {
 	return pgValue{
 		t: types.PgType{
@@ -1345,6 +1390,17 @@ func PgValue(oid uint32, val string) pgValue {
 	}
 }
 
+// PgBinaryValue makes value of PostgreSQL type with given OID from binary representation
+func PgBinaryValue(oid uint32, val []byte) pgValue {
+	return pgValue{
+		t: types.PgType{
+			OID: oid,
+		},
+		val:    string(val),
+		binary: true,
+	}
+}
+
 func SetValue(items ...Value) *setValue {
 	sort.Slice(items, func(i, j int) bool {
 		return items[i].Yql() < items[j].Yql()
@@ -1472,10 +1528,7 @@ func (v *structValue) StructFields() map[string]Value {
 }
 
 func (v *structValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w '%+v' to '%T' destination",
-		ErrCannotCast, v, dst,
-	))
+	return castFieldsToStruct(v, dst)
 }
 
 func (v *structValue) Yql() string {
@@ -1587,10 +1640,7 @@ func (v *tupleValue) castTo(dst interface{}) error {
 		return v.items[0].castTo(dst)
 	}
 
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w '%+v' to '%T' destination",
-		ErrCannotCast, v, dst,
-	))
+	return castItemsToSlice(v, v.items, dst)
 }
 
 func (v *tupleValue) Yql() string {
@@ -1645,6 +1695,14 @@ func (v tzDateValue) castTo(dst interface{}) error {
 	case *string:
 		*vv = string(v)
 
+		return nil
+	case *time.Time:
+		t, err := TzDateToTime(string(v))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		*vv = t
+
 		return nil
 	case *[]byte:
 		*vv = xstring.ToBytes(string(v))
@@ -1681,7 +1739,7 @@ func TzDateValue(v string) tzDateValue {
 }
 
 func TzDateValueFromTime(t time.Time) tzDateValue {
-	return tzDateValue(t.Format(LayoutDate))
+	return tzDateValue(timeToTz(t, LayoutDate))
 }
 
 type tzDatetimeValue string
@@ -1691,6 +1749,14 @@ func (v tzDatetimeValue) castTo(dst interface{}) error {
 	case *string:
 		*vv = string(v)
 
+		return nil
+	case *time.Time:
+		t, err := TzDatetimeToTime(string(v))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		*vv = t
+
 		return nil
 	case *[]byte:
 		*vv = xstring.ToBytes(string(v))
@@ -1727,7 +1793,7 @@ func TzDatetimeValue(v string) tzDatetimeValue {
 }
 
 func TzDatetimeValueFromTime(t time.Time) tzDatetimeValue {
-	return tzDatetimeValue(t.Format(LayoutDatetime))
+	return tzDatetimeValue(timeToTz(t, LayoutTzDatetime))
 }
 
 type tzTimestampValue string
@@ -1737,6 +1803,14 @@ func (v tzTimestampValue) castTo(dst interface{}) error {
 	case *string:
 		*vv = string(v)
 
+		return nil
+	case *time.Time:
+		t, err := TzTimestampToTime(string(v))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		*vv = t
+
 		return nil
 	case *[]byte:
 		*vv = xstring.ToBytes(string(v))
@@ -1773,7 +1847,7 @@ func TzTimestampValue(v string) tzTimestampValue {
 }
 
 func TzTimestampValueFromTime(t time.Time) tzTimestampValue {
-	return tzTimestampValue(t.Format(LayoutTimestamp))
+	return tzTimestampValue(timeToTz(t, LayoutTzTimestamp))
 }
 
 type uint8Value uint8
@@ -2231,6 +2305,42 @@ func VariantValueStruct(v Value, name string, t types.Type) *variantValue {
 	}
 }
 
+type taggedValue struct {
+	tag   string
+	value Value
+}
+
+func (v *taggedValue) Tag() string {
+	return v.tag
+}
+
+func (v *taggedValue) Value() Value {
+	return v.value
+}
+
+func (v *taggedValue) castTo(dst interface{}) error {
+	return v.value.castTo(dst)
+}
+
+func (v *taggedValue) Yql() string {
+	return fmt.Sprintf("AsTagged(%s,%q)", v.value.Yql(), v.tag)
+}
+
+func (v *taggedValue) Type() types.Type {
+	return types.NewTagged(v.tag, v.value.Type())
+}
+
+func (v *taggedValue) toYDB(a *allocator.Allocator) *Ydb.Value {
+	return v.value.toYDB(a)
+}
+
+func TaggedValue(tag string, v Value) *taggedValue {
+	return &taggedValue{
+		tag:   tag,
+		value: v,
+	}
+}
+
 type voidValue struct{}
 
 func (v voidValue) castTo(dst interface{}) error {
@@ -2400,6 +2510,9 @@ func ZeroValue(t types.Type) Value {
 	case *types.Void:
 		return VoidValue()
 
+	case *types.Tagged:
+		return TaggedValue(t.Tag(), ZeroValue(t.InnerType()))
+
 	case *types.List, *types.EmptyList:
 		return &listValue{
 			t: t,
@@ -2410,7 +2523,7 @@ func ZeroValue(t types.Type) Value {
 		}
 	case *types.Dict:
 		return &dictValue{
-			t: t.ValueType(),
+			t: t,
 		}
 	case *types.EmptyDict:
 		return &dictValue{
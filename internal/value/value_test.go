@@ -120,6 +120,7 @@ func TestToYDBFromYDB(t *testing.T) {
 		BytesValue([]byte("test")),
 		DecimalValue([...]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6}, 22, 9),
 		DyNumberValue("123"),
+		TaggedValue("tag", Uint64Value(1)),
 		JSONValue("{}"),
 		JSONDocumentValue("{}"),
 		TzDateValue("1"),
@@ -178,6 +179,7 @@ func TestToYDBFromYDB(t *testing.T) {
 		ZeroValue(types.NewStruct()),
 		ZeroValue(types.NewTuple()),
 		PgValue(pg.OIDInt4, "123"),
+		PgBinaryValue(pg.OIDBytea, []byte{1, 2}),
 	} {
 		t.Run(strconv.Itoa(i)+"."+v.Yql(), func(t *testing.T) {
 			a := allocator.New()
@@ -488,6 +490,10 @@ func TestValueYql(t *testing.T) {
 			value:   DyNumberValue("-1234567890123456"),
 			literal: `DyNumber("-1234567890123456")`,
 		},
+		{
+			value:   TaggedValue("tag", Uint64Value(1)),
+			literal: `AsTagged(1ul,"tag")`,
+		},
 		{
 			value:   JSONValue("{\"a\":-1234567890123456}"),
 			literal: `Json(@@{"a":-1234567890123456}@@)`,
@@ -1089,6 +1095,24 @@ func TestCastOtherTypes(t *testing.T) {
 		result interface{}
 		error  bool
 	}{
+		{
+			v:      PgValue(pg.OIDInt4, "123"),
+			dst:    func(v string) *string { return &v }(""),
+			result: func(v string) *string { return &v }("123"),
+			error:  false,
+		},
+		{
+			v:      PgBinaryValue(pg.OIDBytea, []byte{1, 2}),
+			dst:    func(v []byte) *[]byte { return &v }(nil),
+			result: func(v []byte) *[]byte { return &v }([]byte{1, 2}),
+			error:  false,
+		},
+		{
+			v:      PgValue(pg.OIDInt4, "123"),
+			dst:    func(v int32) *int32 { return &v }(9),
+			result: func(v int32) *int32 { return &v }(9),
+			error:  true,
+		},
 		{
 			v:      BytesValue([]byte("test")),
 			dst:    func(v []byte) *[]byte { return &v }(make([]byte, 0, 10)),
@@ -149,6 +149,16 @@ func IterateByIssues(err error, it func(message string, code Ydb.StatusIds_Statu
 	iterate(o.Issues(), it)
 }
 
+// Issues returns issues tree of operation error or nil if err is not an operation error
+func Issues(err error) []*Ydb_Issue.IssueMessage {
+	var o *operationError
+	if !errors.As(err, &o) {
+		return nil
+	}
+
+	return o.Issues()
+}
+
 func iterate(
 	issues []*Ydb_Issue.IssueMessage,
 	it func(message string, code Ydb.StatusIds_StatusCode, severity uint32),
@@ -69,7 +69,7 @@ func Retryable(err error, opts ...RetryableErrorOption) error {
 			err:                err,
 			name:               "CUSTOM",
 			code:               -1,
-			isRetryObjectValid: true,
+			isRetryObjectValid: false,
 		}
 	)
 	if As(err, &e) {
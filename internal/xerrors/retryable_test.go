@@ -51,3 +51,11 @@ func TestRetryableCode(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryableInvalidObject(t *testing.T) {
+	require.True(t, IsRetryObjectValid(Retryable(fmt.Errorf("some"))))
+	require.False(t, IsRetryObjectValid(Retryable(fmt.Errorf("some"), InvalidObject())))
+	require.False(t, IsRetryObjectValid(Retryable(
+		Operation(WithStatusCode(Ydb.StatusIds_BAD_SESSION)),
+	)))
+}
@@ -33,7 +33,7 @@ func WithSeed(seed int64) option {
 
 func New(opts ...option) Rand {
 	r := &r{
-		r: rand.New(rand.NewSource(time.Now().Unix())), //nolint:gosec
+		r: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
 	}
 	for _, opt := range opts {
 		if opt != nil {
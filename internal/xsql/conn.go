@@ -101,6 +101,16 @@ func (c *conn) IsValid() bool {
 	return c.isReady()
 }
 
+// ResetSession is called by database/sql before reusing of connection from pool.
+// Connections with sessions which are not ready anymore are discarded from pool.
+func (c *conn) ResetSession(context.Context) error {
+	if c.closed.Load() || !c.isReady() {
+		return driver.ErrBadConn
+	}
+
+	return nil
+}
+
 type currentTx interface {
 	driver.Tx
 	driver.ExecerContext
@@ -122,6 +132,7 @@ var (
 	_ driver.QueryerContext     = &conn{}
 	_ driver.Pinger             = &conn{}
 	_ driver.Validator          = &conn{}
+	_ driver.SessionResetter    = &conn{}
 	_ driver.NamedValueChecker  = &conn{}
 
 	_ driver.Result = resultNoRows{}
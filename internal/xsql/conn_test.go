@@ -2,6 +2,16 @@ package xsql
 
 import (
 	"context"
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 )
 
 var (
@@ -49,3 +59,99 @@ var (
 		GetIndexColumns(ctx context.Context, tableName string, indexName string) (columns []string, err error)
 	} = (*conn)(nil)
 )
+
+type resetTestSession struct {
+	table.ClosableSession
+
+	mu     sync.Mutex
+	status table.SessionStatus
+	closed bool
+}
+
+func (s *resetTestSession) Status() table.SessionStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.status
+}
+
+func (s *resetTestSession) setStatus(status table.SessionStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status = status
+}
+
+func (s *resetTestSession) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.closed
+}
+
+func (s *resetTestSession) Close(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+
+	return nil
+}
+
+type resetTestClient struct {
+	table.Client
+
+	sessions []*resetTestSession
+}
+
+func (c *resetTestClient) CreateSession(context.Context, ...table.Option) (table.ClosableSession, error) {
+	s := &resetTestSession{status: table.SessionReady}
+	c.sessions = append(c.sessions, s)
+
+	return s, nil
+}
+
+type resetTestDriver struct {
+	client *resetTestClient
+}
+
+func (d *resetTestDriver) Name() string                { return "/local" }
+func (d *resetTestDriver) Table() table.Client         { return d.client }
+func (d *resetTestDriver) Scripting() scripting.Client { return nil }
+func (d *resetTestDriver) Scheme() scheme.Client       { return nil }
+
+func TestConnResetSession(t *testing.T) {
+	ctx := xtest.Context(t)
+	client := &resetTestClient{}
+	connector, err := Open(&resetTestDriver{client: client})
+	require.NoError(t, err)
+	db := sql.OpenDB(connector)
+	defer func() {
+		_ = db.Close()
+	}()
+	db.SetMaxIdleConns(1)
+
+	cc, err := db.Conn(ctx)
+	require.NoError(t, err)
+	require.NoError(t, cc.Close())
+	require.Len(t, client.sessions, 1)
+
+	t.Run("ReadySessionIsReused", func(t *testing.T) {
+		cc, err := db.Conn(ctx)
+		require.NoError(t, err)
+		require.NoError(t, cc.Close())
+		require.Len(t, client.sessions, 1)
+	})
+	t.Run("NotReadySessionIsDropped", func(t *testing.T) {
+		// session becomes broken while connection is idle in pool of database/sql
+		client.sessions[0].setStatus(table.SessionClosing)
+		cc, err := db.Conn(ctx)
+		require.NoError(t, err)
+		defer func() {
+			_ = cc.Close()
+		}()
+		require.Len(t, client.sessions, 2)
+		require.True(t, client.sessions[0].isClosed())
+		require.False(t, client.sessions[1].isClosed())
+	})
+}
@@ -6,9 +6,7 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
-	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -22,14 +20,6 @@ func Parse(dataSourceName string) (opts []config.Option, connectorOpts []Connect
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 	opts = append(opts, info.Options...)
-	if token := info.Params.Get("token"); token != "" {
-		opts = append(opts, config.WithCredentials(credentials.NewAccessTokenCredentials(token)))
-	}
-	if balancer := info.Params.Get("go_balancer"); balancer != "" {
-		opts = append(opts, config.WithBalancer(balancers.FromConfig(balancer)))
-	} else if balancer := info.Params.Get("balancer"); balancer != "" {
-		opts = append(opts, config.WithBalancer(balancers.FromConfig(balancer)))
-	}
 	if queryMode := info.Params.Get("go_query_mode"); queryMode != "" {
 		mode := QueryModeFromString(queryMode)
 		if mode == UnknownQueryMode {
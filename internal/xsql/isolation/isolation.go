@@ -27,6 +27,23 @@ func ToYDB(opts driver.TxOptions) (txcControl table.TxOption, err error) {
 	}
 
 	return nil, xerrors.WithStackTrace(fmt.Errorf(
-		"unsupported transaction options: %+v", opts,
+		"unsupported transaction options: isolation level %q with read-only=%t", level.String(), opts.ReadOnly,
 	))
 }
+
+// ToYDBTxControl maps read-only driver transaction options which cannot start actual ydb transaction
+// (ReadCommitted to OnlineReadOnly and ReadUncommitted to StaleReadOnly) to transaction control
+// of every query in transaction. It returns false for other options.
+func ToYDBTxControl(opts driver.TxOptions) (txControl *table.TransactionControl, ok bool) {
+	if !opts.ReadOnly {
+		return nil, false
+	}
+	switch sql.IsolationLevel(opts.Isolation) {
+	case sql.LevelReadCommitted:
+		return table.OnlineReadOnlyTxControl(), true
+	case sql.LevelReadUncommitted:
+		return table.StaleReadOnlyTxControl(), true
+	default:
+		return nil, false
+	}
+}
@@ -164,3 +164,53 @@ func TestToYDB(t *testing.T) {
 		})
 	}
 }
+
+func TestToYDBTxControl(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		txOptions driver.TxOptions
+		txControl *table.TransactionControl
+	}{
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelReadCommitted),
+				ReadOnly:  true,
+			},
+			txControl: table.OnlineReadOnlyTxControl(),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelReadUncommitted),
+				ReadOnly:  true,
+			},
+			txControl: table.StaleReadOnlyTxControl(),
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelReadCommitted),
+				ReadOnly:  false,
+			},
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			txOptions: driver.TxOptions{
+				Isolation: driver.IsolationLevel(sql.LevelSnapshot),
+				ReadOnly:  true,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			txControl, ok := ToYDBTxControl(tt.txOptions)
+			if tt.txControl == nil {
+				require.False(t, ok)
+				require.Nil(t, txControl)
+			} else {
+				require.True(t, ok)
+				require.Equal(t, tt.txControl.Desc().String(), txControl.Desc().String())
+			}
+		})
+	}
+}
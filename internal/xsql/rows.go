@@ -38,6 +38,13 @@ type rows struct {
 	nextSet sync.Once
 }
 
+// NewRows makes database/sql rows over result without connection (e.g. for fake drivers in tests)
+func NewRows(res result.BaseResult) driver.Rows {
+	return &rows{
+		result: res,
+	}
+}
+
 func (r *rows) LastInsertId() (int64, error) { return 0, ErrUnsupported }
 func (r *rows) RowsAffected() (int64, error) { return 0, ErrUnsupported }
 
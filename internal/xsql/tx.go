@@ -36,6 +36,14 @@ func (c *conn) beginTx(ctx context.Context, txOptions driver.TxOptions) (current
 			),
 		)
 	}
+	if txControl, ok := isolation.ToYDBTxControl(txOptions); ok {
+		return &txFake{
+			beginCtx:  ctx,
+			conn:      c,
+			ctx:       ctx,
+			txControl: txControl,
+		}, nil
+	}
 	txc, err := isolation.ToYDB(txOptions)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -15,6 +15,9 @@ type txFake struct {
 	beginCtx context.Context //nolint:containedctx
 	conn     *conn
 	ctx      context.Context //nolint:containedctx
+
+	// txControl is a transaction control of every query in fake transaction (if defined)
+	txControl *table.TransactionControl
 }
 
 func (tx *txFake) PrepareContext(ctx context.Context, query string) (_ driver.Stmt, finalErr error) {
@@ -109,6 +112,9 @@ func (tx *txFake) QueryContext(ctx context.Context, query string, args []driver.
 	defer func() {
 		onDone(err)
 	}()
+	if tx.txControl != nil {
+		ctx = WithTxControl(ctx, tx.txControl)
+	}
 	rows, err = tx.conn.QueryContext(ctx, query, args)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -128,6 +134,9 @@ func (tx *txFake) ExecContext(ctx context.Context, query string, args []driver.N
 	defer func() {
 		onDone(err)
 	}()
+	if tx.txControl != nil {
+		ctx = WithTxControl(ctx, tx.txControl)
+	}
 	result, err = tx.conn.ExecContext(ctx, query, args)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -0,0 +1,8 @@
+package xstring
+
+import "strings"
+
+// QuoteIdentifier quotes name (of table, column, etc.) as YQL identifier in backticks
+func QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "\\`") + "`"
+}
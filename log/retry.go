@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -48,11 +49,22 @@ func internalRetry(l Logger, d trace.Detailer) (t trace.Retry) {
 					Bool("retryable", m.MustRetry(idempotent)),
 					Int64("code", m.StatusCode()),
 					Bool("deleteSession", m.IsRetryObjectValid()),
+					Stringer("issues", m.Issues()),
 					versionField(),
 				)
 			}
 		}
 	}
+	t.OnCircuitBreakerStateChange = func(info trace.RetryCircuitBreakerStateChangeInfo) {
+		if d.Details()&trace.RetryEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "retry", "circuit", "breaker")
+		l.Log(ctx, "state changed",
+			String("from", info.From),
+			String("to", info.To),
+		)
+	}
 
 	return t
 }
@@ -255,6 +255,8 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 							String("query", query),
 							String("id", session.ID()),
 							String("status", session.Status()),
+							Bool("cache_hit", info.CacheHit),
+							Int("cache_size", info.CacheSize),
 							latencyField(start),
 						)...,
 					)...,
@@ -552,6 +554,11 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 		ctx := with(context.Background(), TRACE, "ydb", "table", "pool", "state", "change")
 		l.Log(WithLevel(ctx, DEBUG), "",
 			Int("size", info.Size),
+			Int("limit", info.Limit),
+			Int("idle", info.Idle),
+			Int("in_use", info.InUse),
+			Int("wait_q", info.WaitQ),
+			Int("create_in_progress", info.CreateInProgress),
 			String("event", info.Event),
 		)
 	}
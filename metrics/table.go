@@ -11,6 +11,9 @@ import (
 func table(config Config) (t trace.Table) {
 	config = config.WithSystem("table")
 	alive := config.GaugeVec("sessions", "node_id")
+	created := config.CounterVec("created", "node_id")
+	deleted := config.CounterVec("deleted", "node_id")
+	keepAliveErrors := config.WithSystem("keepalive").CounterVec("errors")
 	config = config.WithSystem("pool")
 	limit := config.GaugeVec("limit")
 	size := config.GaugeVec("size")
@@ -18,6 +21,9 @@ func table(config Config) (t trace.Table) {
 	inflightLatency := config.WithSystem("inflight").TimerVec("latency")
 	wait := config.GaugeVec("wait")
 	waitLatency := config.WithSystem("wait").TimerVec("latency")
+	idle := config.GaugeVec("idle")
+	inUse := config.GaugeVec("in_use")
+	waitQ := config.GaugeVec("wait_queue")
 	t.OnInit = func(info trace.TableInitStartInfo) func(trace.TableInitDoneInfo) {
 		return func(info trace.TableInitDoneInfo) {
 			limit.With(nil).Set(float64(info.Limit))
@@ -26,21 +32,39 @@ func table(config Config) (t trace.Table) {
 	t.OnSessionNew = func(info trace.TableSessionNewStartInfo) func(trace.TableSessionNewDoneInfo) {
 		return func(info trace.TableSessionNewDoneInfo) {
 			if info.Error == nil && config.Details()&trace.TableSessionEvents != 0 {
-				alive.With(map[string]string{
+				nodeID := map[string]string{
 					"node_id": idToString(info.Session.NodeID()),
-				}).Add(1)
+				}
+				alive.With(nodeID).Add(1)
+				created.With(nodeID).Inc()
 			}
 		}
 	}
 	t.OnSessionDelete = func(info trace.TableSessionDeleteStartInfo) func(trace.TableSessionDeleteDoneInfo) {
 		if config.Details()&trace.TableSessionEvents != 0 {
-			alive.With(map[string]string{
+			nodeID := map[string]string{
 				"node_id": idToString(info.Session.NodeID()),
-			}).Add(-1)
+			}
+			alive.With(nodeID).Add(-1)
+			deleted.With(nodeID).Inc()
 		}
 
 		return nil
 	}
+	t.OnSessionKeepAlive = func(info trace.TableKeepAliveStartInfo) func(trace.TableKeepAliveDoneInfo) {
+		return func(info trace.TableKeepAliveDoneInfo) {
+			if info.Error != nil && config.Details()&trace.TableSessionEvents != 0 {
+				keepAliveErrors.With(nil).Inc()
+			}
+		}
+	}
+	t.OnPoolStateChange = func(info trace.TablePoolStateChangeInfo) {
+		if config.Details()&trace.TablePoolEvents != 0 {
+			idle.With(nil).Set(float64(info.Idle))
+			inUse.With(nil).Set(float64(info.InUse))
+			waitQ.With(nil).Set(float64(info.WaitQ))
+		}
+	}
 	t.OnPoolSessionAdd = func(info trace.TablePoolSessionAddInfo) {
 		if config.Details()&trace.TablePoolEvents != 0 {
 			size.With(nil).Add(1)
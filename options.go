@@ -9,6 +9,9 @@ import (
 	"path/filepath"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
@@ -26,6 +29,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -102,6 +107,9 @@ func WithRequestsType(requestsType string) Option {
 //
 //	grpc[s]://{endpoint}/{database}[?param=value]
 //
+// Supported params: database, token (access token), token_file (path to file with access token)
+// and balancer (balancer type, "prefer_local" or JSON config of balancer, see balancers.FromConfig)
+//
 // Warning: WithConnectionString will be removed at next major release
 //
 // (Driver string will be required string param of ydb.Open)
@@ -192,6 +200,8 @@ func WithMinTLSVersion(minVersion uint16) Option {
 }
 
 // WithTLSSInsecureSkipVerify applies InsecureSkipVerify flag to TLS config
+//
+// Server certificate is not verified, so use it only with development clusters.
 func WithTLSSInsecureSkipVerify() Option {
 	return func(ctx context.Context, c *Driver) error {
 		c.options = append(c.options, config.WithTLSSInsecureSkipVerify())
@@ -260,6 +270,46 @@ func WithDialTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithUnaryClientInterceptor appends grpc unary client interceptors to all unary RPCs of driver
+// (including discovery calls)
+//
+// Interceptors are chained in order of registration. Use them for custom auth, request logging or chaos-injection middlewares.
+func WithUnaryClientInterceptor(interceptors ...grpc.UnaryClientInterceptor) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithUnaryClientInterceptor(interceptors...))
+
+		return nil
+	}
+}
+
+// WithStreamClientInterceptor appends grpc stream client interceptors to all streaming RPCs of driver
+//
+// Interceptors are chained in order of registration.
+func WithStreamClientInterceptor(interceptors ...grpc.StreamClientInterceptor) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithStreamClientInterceptor(interceptors...))
+
+		return nil
+	}
+}
+
+// WithGrpcCompression enables compression of all grpc messages of driver
+//
+// Name is a name of compressor registered in google.golang.org/grpc/encoding, such as
+// config.GrpcCompressionGzip. Other compressors (for example zstd) must be registered with
+// encoding.RegisterCompressor before. Compression costs CPU and helps with large and highly
+// compressible payloads (such as BulkUpsert rows or huge query params and result sets).
+func WithGrpcCompression(name string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		if encoding.GetCompressor(name) == nil {
+			return xerrors.WithStackTrace(fmt.Errorf("unknown grpc compressor %q", name))
+		}
+		c.options = append(c.options, config.WithGrpcCompression(name))
+
+		return nil
+	}
+}
+
 // With collects additional configuration options.
 //
 // This option does not replace collected option, instead it will append provided options.
@@ -342,17 +392,7 @@ func WithCertificate(cert *x509.Certificate) Option {
 
 // WithCertificatesFromFile appends certificates by filepath to TLS config root certificates
 func WithCertificatesFromFile(caFile string, opts ...certificates.FromFileOption) Option {
-	if len(caFile) > 0 && caFile[0] == '~' {
-		if home, err := os.UserHomeDir(); err == nil {
-			caFile = filepath.Join(home, caFile[1:])
-		}
-	}
-	if file, err := filepath.Abs(caFile); err == nil {
-		caFile = file
-	}
-	if file, err := filepath.EvalSymlinks(caFile); err == nil {
-		caFile = file
-	}
+	caFile = expandFilePath(caFile)
 
 	return func(ctx context.Context, c *Driver) error {
 		certs, err := certificates.FromFile(caFile, opts...)
@@ -369,10 +409,51 @@ func WithCertificatesFromFile(caFile string, opts ...certificates.FromFileOption
 	}
 }
 
+// WithClientCertificate appends client certificate to TLS config for mutual TLS authentication (mTLS)
+func WithClientCertificate(cert tls.Certificate) Option { //nolint:gocritic
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithClientCertificate(cert))
+
+		return nil
+	}
+}
+
+// WithClientCertificateFromFile loads client certificate and its private key from pem-encoded files
+// and appends it to TLS config for mutual TLS authentication (mTLS)
+func WithClientCertificateFromFile(certFile, keyFile string) Option {
+	certFile, keyFile = expandFilePath(certFile), expandFilePath(keyFile)
+
+	return func(ctx context.Context, c *Driver) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return WithClientCertificate(cert)(ctx, c)
+	}
+}
+
+// expandFilePath expands home directory prefix ~ and symlinks of path
+func expandFilePath(path string) string {
+	if len(path) > 0 && path[0] == '~' {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[1:])
+		}
+	}
+	if file, err := filepath.Abs(path); err == nil {
+		path = file
+	}
+	if file, err := filepath.EvalSymlinks(path); err == nil {
+		path = file
+	}
+
+	return path
+}
+
 // WithTLSConfig replaces older TLS config
 //
 // Warning: all early TLS config changes (such as WithCertificate, WithCertificatesFromFile, WithCertificatesFromPem,
-// WithMinTLSVersion, WithTLSSInsecureSkipVerify) will be lost
+// WithClientCertificate, WithMinTLSVersion, WithTLSSInsecureSkipVerify) will be lost
 func WithTLSConfig(tlsConfig *tls.Config) Option {
 	return func(ctx context.Context, c *Driver) error {
 		c.options = append(c.options, config.WithTLSConfig(tlsConfig))
@@ -459,6 +540,100 @@ func WithSessionPoolDeleteTimeout(deleteTimeout time.Duration) Option {
 	}
 }
 
+// WithSessionPoolMinIdleSessions set number of sessions which table.Client creates at start and
+// keeps idle for avoiding latency spikes on session creation
+func WithSessionPoolMinIdleSessions(minIdleSessions int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithMinIdleSessions(minIdleSessions))
+
+		return nil
+	}
+}
+
+// WithSessionPoolWaitQueueLimit set maximum number of callers waiting for session when
+// session pool of table.Client is exhausted.
+// Callers above this limit receive table.ErrSessionPoolQueueOverflow error instead of waiting.
+func WithSessionPoolWaitQueueLimit(waitQueueLimit int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithWaitQueueLimit(waitQueueLimit))
+
+		return nil
+	}
+}
+
+// WithSessionPoolDeleteConcurrency set maximum number of broken sessions which are deleted
+// in background concurrently by table.Client.
+func WithSessionPoolDeleteConcurrency(deleteConcurrency int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithDeleteConcurrency(deleteConcurrency))
+
+		return nil
+	}
+}
+
+// WithSessionPoolOnSessionCreated appends callback which table.Client calls after creation of each session.
+// Unlike trace callbacks it allows to keep own registry of sessions alive.
+func WithSessionPoolOnSessionCreated(onCreated func(s table.SessionInfo)) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithOnSessionCreated(onCreated))
+
+		return nil
+	}
+}
+
+// WithSessionPoolOnSessionDeleted appends callback which table.Client calls after deletion of each session.
+func WithSessionPoolOnSessionDeleted(onDeleted func(s table.SessionInfo)) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithOnSessionDeleted(onDeleted))
+
+		return nil
+	}
+}
+
+// WithSessionPoolOnSessionStateChanged appends callback which table.Client calls on each change of session status.
+func WithSessionPoolOnSessionStateChanged(
+	onStateChanged func(s table.SessionInfo, status table.SessionStatus),
+) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithOnSessionStateChanged(onStateChanged))
+
+		return nil
+	}
+}
+
+// WithSessionPoolMaxSessionAge set maximum lifetime of session in table.Client.
+// Sessions older than maxSessionAge are closed on return into the pool and recreated on demand,
+// which spreads sessions across nodes after cluster scale-out.
+func WithSessionPoolMaxSessionAge(maxSessionAge time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithMaxSessionAge(maxSessionAge))
+
+		return nil
+	}
+}
+
+// WithSessionPoolCreateSessionRateLimit limits rate of session creations in table.Client
+// for avoiding bursts of CreateSession requests on start of many application instances.
+// Session creations above the rate are queued.
+func WithSessionPoolCreateSessionRateLimit(ratePerSecond float64) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithCreateSessionRateLimit(ratePerSecond))
+
+		return nil
+	}
+}
+
+// WithSessionPoolNodeBalancing makes table.Client create new sessions on discovered nodes with the least
+// number of pooled sessions, so sessions are spread evenly across nodes after changes of cluster topology.
+// By default node of new session is chosen by server-side balancing.
+func WithSessionPoolNodeBalancing() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithNodeBalancing())
+
+		return nil
+	}
+}
+
 // WithSessionPoolKeepAliveMinSize set minimum sessions should be keeped alive in table.Client
 //
 // Deprecated: use WithApplicationName instead.
@@ -486,6 +661,62 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithCopyBytesScan enables copying of String and Utf8 values of table results scanned into []byte
+// destinations. By default scanned bytes share memory with result buffer, so they are valid only
+// until next row and must not be modified.
+func WithCopyBytesScan() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithCopyBytesScan())
+
+		return nil
+	}
+}
+
+// WithColumnMatching sets mode of matching of columns passed to NextResultSet(ctx, columns...)
+// of table results with columns of result set: by names skipping extra columns (default),
+// strictly by names or by positions. Mismatch is reported with result.ErrColumnMismatch.
+func WithColumnMatching(columnMatching result.ColumnMatching) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithColumnMatching(columnMatching))
+
+		return nil
+	}
+}
+
+// WithStrictNullScan enables errors on scanning of NULL values of table results into destinations
+// which cannot hold NULL (not pointers and not sql.Scanner). By default NULL is scanned as zero value.
+// Scan returns result.ErrNullValue with column name on NULL values in strict mode.
+// Strict mode can be enabled for single query with options.WithStrictNullScan().
+func WithStrictNullScan() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithStrictNullScan())
+
+		return nil
+	}
+}
+
+// WithKeepInCache enables keep-in-cache flag of query cache policy for all table data queries
+//
+// Keep-in-cache flag may be disabled for single call with options.WithKeepInCache(false)
+func WithKeepInCache() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithKeepInCache())
+
+		return nil
+	}
+}
+
+// WithPreparedStatementsCacheSize enables per-session LRU cache of prepared statements
+// with given capacity, so repeated Session.Prepare calls with the same query text
+// reuse already prepared statement
+func WithPreparedStatementsCacheSize(size int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithPreparedStatementsCacheSize(size))
+
+		return nil
+	}
+}
+
 // WithPanicCallback specified behavior on panic
 // Warning: WithPanicCallback must be defined on start of all options
 // (before `WithTrace{Driver,Table,Scheme,Scripting,Coordination,Ratelimiter}` and other options)
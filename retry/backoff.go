@@ -6,7 +6,11 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 )
 
-// Backoff makes backoff object with custom params
+// BackoffStrategy is the interface of delaying logic between retry attempts.
+// Custom implementations can be passed into WithFastBackoff and WithSlowBackoff options.
+type BackoffStrategy = backoff.Backoff
+
+// Backoff makes exponential backoff object with custom params
 func Backoff(slotDuration time.Duration, ceiling uint, jitterLimit float64) backoff.Backoff {
 	return backoff.New(
 		backoff.WithSlotDuration(slotDuration),
@@ -14,3 +18,22 @@ func Backoff(slotDuration time.Duration, ceiling uint, jitterLimit float64) back
 		backoff.WithJitterLimit(jitterLimit),
 	)
 }
+
+// ConstantBackoff makes backoff object with the same delay for each retry attempt
+func ConstantBackoff(delay time.Duration) backoff.Backoff {
+	return backoff.Constant(delay)
+}
+
+// FibonacciBackoff makes backoff object with delays growing as slotDuration multiplied
+// to Fibonacci numbers (1, 1, 2, 3, 5, ...) up to ceiling-th number (counting from 1)
+func FibonacciBackoff(slotDuration time.Duration, ceiling uint) backoff.Backoff {
+	return backoff.Fibonacci(slotDuration, ceiling)
+}
+
+// DecorrelatedJitterBackoff makes backoff object with "decorrelated jitter" policy: each delay
+// is a random value between base and three times the previous delay, but no more than ceiling.
+// Unlike exponential backoff such delays are not synchronized between many clients
+// which retry after the same failure (e.g. node restart).
+func DecorrelatedJitterBackoff(base, ceiling time.Duration) backoff.Backoff {
+	return backoff.DecorrelatedJitter(base, ceiling)
+}
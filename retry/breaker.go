@@ -0,0 +1,182 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// ErrCircuitBreakerOpen is returned by Retry without calling of operation while circuit breaker is open
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrCircuitBreakerOpen = xerrors.Wrap(errors.New("circuit breaker is open"))
+
+type circuitBreakerState string
+
+const (
+	circuitBreakerClosed   = circuitBreakerState("closed")
+	circuitBreakerOpen     = circuitBreakerState("open")
+	circuitBreakerHalfOpen = circuitBreakerState("half-open")
+)
+
+// CircuitBreaker opens after threshold consecutive transport failures and short-circuits
+// retry attempts with ErrCircuitBreakerOpen during cool-down period.
+// After cool-down period circuit breaker becomes half-open and allows single probe attempt:
+// success of probe closes circuit breaker, transport failure opens it again.
+// Other results of probe (operation errors, context cancellation or panic) keep circuit breaker
+// half-open and allow next probe attempt.
+//
+// CircuitBreaker is safe for concurrent use and must be shared between retry loops
+// to the same endpoint or database.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type CircuitBreaker struct {
+	clock     clockwork.Clock
+	threshold int
+	coolDown  time.Duration
+
+	mu       sync.Mutex
+	state    circuitBreakerState
+	failures int
+	openedAt time.Time
+	probe    bool // probe attempt in half-open state is in flight
+}
+
+type circuitBreakerOption func(b *CircuitBreaker)
+
+func withCircuitBreakerClock(clock clockwork.Clock) circuitBreakerOption {
+	return func(b *CircuitBreaker) {
+		b.clock = clock
+	}
+}
+
+// NewCircuitBreaker makes circuit breaker which opens after threshold consecutive transport
+// failures for coolDown period.
+// If threshold is less than or equal to zero then threshold of 1 is used.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewCircuitBreaker(threshold int, coolDown time.Duration, opts ...circuitBreakerOption) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = 1
+	}
+	b := &CircuitBreaker{
+		clock:     clockwork.NewRealClock(),
+		threshold: threshold,
+		coolDown:  coolDown,
+		state:     circuitBreakerClosed,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(b)
+		}
+	}
+
+	return b
+}
+
+// allow checks that retry attempt can be made
+func (b *CircuitBreaker) allow(t *trace.Retry) error {
+	b.mu.Lock()
+	from := b.state
+	switch {
+	case b.state == circuitBreakerOpen && b.clock.Since(b.openedAt) >= b.coolDown:
+		b.state, b.probe = circuitBreakerHalfOpen, true
+	case b.state == circuitBreakerHalfOpen && !b.probe:
+		b.probe = true
+	case b.state != circuitBreakerClosed:
+		b.mu.Unlock()
+
+		return xerrors.WithStackTrace(ErrCircuitBreakerOpen)
+	}
+	to := b.state
+	b.mu.Unlock()
+
+	if from != to {
+		trace.RetryOnCircuitBreakerStateChange(t, string(from), string(to))
+	}
+
+	return nil
+}
+
+// call makes retry attempt with op and accounts its result.
+// Probe attempt is released even if op panics.
+func (b *CircuitBreaker) call(ctx context.Context, t *trace.Retry, op func() error) error {
+	reported := false
+	defer func() {
+		if !reported {
+			b.release()
+		}
+	}()
+
+	err := op()
+	b.report(ctx, err, t)
+	reported = true
+
+	return err
+}
+
+// release allows next probe attempt in half-open state
+func (b *CircuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probe = false
+}
+
+// report accounts result of retry attempt
+func (b *CircuitBreaker) report(ctx context.Context, err error, t *trace.Retry) {
+	b.mu.Lock()
+	from := b.state
+	b.probe = false
+	switch {
+	case err == nil:
+		b.failures = 0
+		b.state = circuitBreakerClosed
+	case ctx.Err() != nil || xerrors.IsContextError(err):
+		// attempt was interrupted by caller, so result says nothing about endpoint
+	case xerrors.IsTransportError(err):
+		b.failures++
+		if b.state == circuitBreakerHalfOpen || b.failures >= b.threshold {
+			b.state, b.openedAt = circuitBreakerOpen, b.clock.Now()
+		}
+	case b.state == circuitBreakerClosed:
+		b.failures = 0
+	}
+	to := b.state
+	b.mu.Unlock()
+
+	if from != to {
+		trace.RetryOnCircuitBreakerStateChange(t, string(from), string(to))
+	}
+}
+
+var _ Option = breakerOption{}
+
+type breakerOption struct {
+	b *CircuitBreaker
+}
+
+func (o breakerOption) ApplyRetryOption(opts *retryOptions) {
+	opts.breaker = o.b
+}
+
+func (o breakerOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+func (o breakerOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+// WithCircuitBreaker attaches circuit breaker to retry loop
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCircuitBreaker(b *CircuitBreaker) breakerOption {
+	return breakerOption{b: b}
+}
@@ -0,0 +1,138 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var (
+		clock       = clockwork.NewFakeClock()
+		b           = NewCircuitBreaker(3, time.Minute, withCircuitBreakerClock(clock))
+		transitions []string
+		opts        = []Option{
+			WithIdempotent(true),
+			WithCircuitBreaker(b),
+			WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+			WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+			WithTrace(&trace.Retry{
+				OnCircuitBreakerStateChange: func(info trace.RetryCircuitBreakerStateChangeInfo) {
+					transitions = append(transitions, info.From+"->"+info.To)
+				},
+			}),
+		}
+		transportErr = xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+	)
+
+	attempts := 0
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		return transportErr
+	}, opts...)
+	require.ErrorIs(t, err, ErrCircuitBreakerOpen)
+	require.Equal(t, 3, attempts)
+	require.Equal(t, []string{"closed->open"}, transitions)
+
+	attempts = 0
+	err = Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		return nil
+	}, opts...)
+	require.ErrorIs(t, err, ErrCircuitBreakerOpen)
+	require.Equal(t, 0, attempts)
+
+	clock.Advance(time.Minute)
+	err = Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		return transportErr
+	}, opts...)
+	require.ErrorIs(t, err, ErrCircuitBreakerOpen)
+	require.Equal(t, 1, attempts)
+	require.Equal(t, []string{"closed->open", "open->half-open", "half-open->open"}, transitions)
+
+	clock.Advance(time.Minute)
+	err = Retry(context.Background(), func(ctx context.Context) error {
+		return errors.New("non-retryable error")
+	}, opts...)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrCircuitBreakerOpen)
+	require.Equal(t, []string{
+		"closed->open", "open->half-open", "half-open->open", "open->half-open",
+	}, transitions)
+
+	err = Retry(context.Background(), func(ctx context.Context) error {
+		return nil
+	}, opts...)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed",
+	}, transitions)
+}
+
+func TestCircuitBreakerHalfOpenProbe(t *testing.T) {
+	var (
+		clock        = clockwork.NewFakeClock()
+		b            = NewCircuitBreaker(1, time.Minute, withCircuitBreakerClock(clock))
+		transportErr = xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+		opts         = []Option{
+			WithIdempotent(true),
+			WithCircuitBreaker(b),
+			WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+		}
+	)
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		return transportErr
+	}, opts...)
+	require.ErrorIs(t, err, ErrCircuitBreakerOpen)
+	clock.Advance(time.Minute)
+
+	t.Run("Panic", func(t *testing.T) {
+		require.Panics(t, func() {
+			_ = Retry(context.Background(), func(ctx context.Context) error {
+				panic("probe panic")
+			}, opts...)
+		})
+		require.Equal(t, circuitBreakerHalfOpen, b.state)
+		require.False(t, b.probe)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		err := Retry(ctx, func(ctx context.Context) error {
+			cancel()
+
+			return ctx.Err()
+		}, opts...)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, circuitBreakerHalfOpen, b.state)
+		require.False(t, b.probe)
+	})
+	t.Run("OperationError", func(t *testing.T) {
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST))
+		}, opts...)
+		require.Error(t, err)
+		require.Equal(t, circuitBreakerHalfOpen, b.state)
+		require.False(t, b.probe)
+	})
+	t.Run("Success", func(t *testing.T) {
+		require.NoError(t, Retry(context.Background(), func(ctx context.Context) error {
+			return nil
+		}, opts...))
+		require.Equal(t, circuitBreakerClosed, b.state)
+	})
+}
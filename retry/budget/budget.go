@@ -3,6 +3,7 @@ package budget
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/jonboulle/clockwork"
@@ -28,6 +29,15 @@ type (
 		percent int
 		rand    xrand.Rand
 	}
+	tokenBucket struct {
+		clock  clockwork.Clock
+		rate   float64 // tokens per second
+		burst  float64
+		mu     sync.Mutex
+		tokens float64
+		last   time.Time
+	}
+	tokenBucketOption func(b *tokenBucket)
 )
 
 func withFixedBudgetClock(clock clockwork.Clock) fixedBudgetOption {
@@ -116,3 +126,58 @@ func (b *percentBudget) Acquire(ctx context.Context) error {
 
 	return ErrNoQuota
 }
+
+func withTokenBucketClock(clock clockwork.Clock) tokenBucketOption {
+	return func(b *tokenBucket) {
+		b.clock = clock
+	}
+}
+
+// TokenBucket returns budget which is shared between all retry loops it attached to
+// (e.g. with ydb.WithRetryBudget) and caps the aggregate rate of retry attempts.
+// Bucket holds up to burst tokens and refills with attemptsPerSecond rate.
+// Unlike Limited it does not wait for quota: if budget is exhausted Acquire
+// returns ErrNoQuota immediately, which prevents retry storms under cluster-wide outages.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func TokenBucket(attemptsPerSecond float64, burst int, opts ...tokenBucketOption) *tokenBucket {
+	if attemptsPerSecond < 0 || burst <= 0 {
+		panic(fmt.Sprintf("wrong token bucket params: rate=%v, burst=%d", attemptsPerSecond, burst))
+	}
+	b := &tokenBucket{
+		clock:  clockwork.NewRealClock(),
+		rate:   attemptsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	b.last = b.clock.Now()
+
+	return b
+}
+
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (b *tokenBucket) Acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return xerrors.WithStackTrace(ErrNoQuota)
+	}
+	b.tokens--
+
+	return nil
+}
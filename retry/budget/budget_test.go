@@ -70,3 +70,21 @@ func TestPercent(t *testing.T) {
 		require.LessOrEqual(t, success, int(float64(total)*(percent+0.1*percent)))
 	}, xtest.StopAfter(5*time.Second))
 }
+
+func TestTokenBucket(t *testing.T) {
+	ctx := xtest.Context(t)
+	clock := clockwork.NewFakeClock()
+	b := TokenBucket(2, 2, withTokenBucketClock(clock))
+	require.NoError(t, b.Acquire(ctx))
+	require.NoError(t, b.Acquire(ctx))
+	require.ErrorIs(t, b.Acquire(ctx), ErrNoQuota)
+
+	clock.Advance(time.Second / 2)
+	require.NoError(t, b.Acquire(ctx))
+	require.ErrorIs(t, b.Acquire(ctx), ErrNoQuota)
+
+	clock.Advance(time.Hour)
+	require.NoError(t, b.Acquire(ctx))
+	require.NoError(t, b.Acquire(ctx))
+	require.ErrorIs(t, b.Acquire(ctx), ErrNoQuota)
+}
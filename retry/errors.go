@@ -1,10 +1,16 @@
 package retry
 
 import (
+	"errors"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
 )
 
+// ErrWouldExceedDeadline marks error of Retry which stopped early because the next backoff delay
+// would overrun the context deadline. Such error also wraps the last error of retry operation.
+var ErrWouldExceedDeadline = xerrors.Wrap(errors.New("next retry attempt would exceed deadline"))
+
 func unwrapErrBadConn(err error) error {
 	var e *badconn.Error
 	if xerrors.As(err, &e) {
@@ -1,8 +1,14 @@
 package retry
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
 
 // retryMode reports whether operation is able retried and with which properties.
@@ -11,6 +17,66 @@ type retryMode struct {
 	errType            xerrors.Type
 	backoff            backoff.Type
 	isRetryObjectValid bool
+	issues             Issues
+}
+
+// Issue is a node of issues tree of operation error
+type Issue struct {
+	Message  string
+	Code     uint32
+	Severity uint32
+	Issues   Issues
+}
+
+// Issues is a list of issues of operation error
+type Issues []Issue
+
+func (ii Issues) String() string {
+	if len(ii) == 0 {
+		return ""
+	}
+	b := xstring.Buffer()
+	defer b.Free()
+	b.WriteByte('[')
+	for i := range ii {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('{')
+		if code := ii[i].Code; code != 0 {
+			b.WriteByte('#')
+			b.WriteString(strconv.Itoa(int(code)))
+			b.WriteByte(' ')
+		}
+		b.WriteByte('\'')
+		b.WriteString(strings.TrimSuffix(ii[i].Message, "."))
+		b.WriteByte('\'')
+		if len(ii[i].Issues) > 0 {
+			b.WriteByte(' ')
+			b.WriteString(ii[i].Issues.String())
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(']')
+
+	return b.String()
+}
+
+func issuesFromProto(issues []*Ydb_Issue.IssueMessage) Issues {
+	if len(issues) == 0 {
+		return nil
+	}
+	ii := make(Issues, 0, len(issues))
+	for _, issue := range issues {
+		ii = append(ii, Issue{
+			Message:  issue.GetMessage(),
+			Code:     issue.GetIssueCode(),
+			Severity: issue.GetSeverity(),
+			Issues:   issuesFromProto(issue.GetIssues()),
+		})
+	}
+
+	return ii
 }
 
 func (m retryMode) MustRetry(isOperationIdempotent bool) bool {
@@ -36,3 +102,6 @@ func (m retryMode) BackoffType() backoff.Type { return m.backoff }
 func (m retryMode) MustDeleteSession() bool { return !m.isRetryObjectValid }
 
 func (m retryMode) IsRetryObjectValid() bool { return m.isRetryObjectValid }
+
+// Issues returns issues tree of operation error (nested issue messages and codes)
+func (m retryMode) Issues() Issues { return m.issues }
@@ -0,0 +1,88 @@
+package retry
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Policy defines retry behavior for operation errors with some YDB status code
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Policy struct {
+	// Retryable reports whether operation error must be retried
+	Retryable bool
+
+	// IdempotentOnly reports whether operation error must be retried only for idempotent operations
+	IdempotentOnly bool
+
+	// Backoff overrides backoff type before next retry attempt (TypeNoBackoff, TypeFastBackoff or TypeSlowBackoff).
+	// If Backoff is nil, then default backoff of status code is used. Status codes which are not retryable
+	// by default and have no default backoff are retried with TypeFastBackoff.
+	Backoff *backoff.Type
+
+	// DeleteSession overrides whether session (retry object) must be deleted after operation error.
+	// If DeleteSession is nil, then default rules of Check are used.
+	DeleteSession *bool
+}
+
+var _ Option = policiesOption{}
+
+type policiesOption map[Ydb.StatusIds_StatusCode]Policy
+
+func (policies policiesOption) ApplyRetryOption(opts *retryOptions) {
+	if opts.policies == nil {
+		opts.policies = make(map[Ydb.StatusIds_StatusCode]Policy, len(policies))
+	}
+	for code, p := range policies {
+		opts.policies[code] = p
+	}
+}
+
+func (policies policiesOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, policies)
+}
+
+func (policies policiesOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, policies)
+}
+
+// WithPolicies overrides default retry behavior for operation errors with given YDB status codes.
+// For example, OVERLOADED errors can be retried with fast backoff or BAD_REQUEST errors can be retried at all.
+// Status codes which are not present in policies are checked with default rules of Check.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPolicies(policies map[Ydb.StatusIds_StatusCode]Policy) policiesOption {
+	return policies
+}
+
+func (m retryMode) withPolicies(err error, policies map[Ydb.StatusIds_StatusCode]Policy) retryMode {
+	code := Ydb.StatusIds_StatusCode(m.code)
+	p, has := policies[code]
+	if !has || !xerrors.IsOperationError(err, code) {
+		return m
+	}
+
+	wasRetryable := m.errType == xerrors.TypeRetryable || m.errType == xerrors.TypeConditionallyRetryable
+
+	switch {
+	case !p.Retryable:
+		m.errType = xerrors.TypeNonRetryable
+	case p.IdempotentOnly:
+		m.errType = xerrors.TypeConditionallyRetryable
+	default:
+		m.errType = xerrors.TypeRetryable
+	}
+	switch {
+	case p.Backoff != nil:
+		m.backoff = *p.Backoff
+	case p.Retryable && !wasRetryable && m.backoff == backoff.TypeNoBackoff:
+		m.backoff = backoff.TypeFast
+	}
+	if p.DeleteSession != nil {
+		m.isRetryObjectValid = *p.DeleteSession
+	}
+
+	return m
+}
@@ -0,0 +1,125 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestWithPolicies(t *testing.T) {
+	fastBackoff := TypeFastBackoff
+	for _, tt := range []struct {
+		name       string
+		code       Ydb.StatusIds_StatusCode
+		policies   map[Ydb.StatusIds_StatusCode]Policy
+		idempotent bool
+		attempts   int
+	}{
+		{
+			name:     "DefaultRetryable",
+			code:     Ydb.StatusIds_UNAVAILABLE,
+			attempts: 3,
+		},
+		{
+			name: "OverrideToNonRetryable",
+			code: Ydb.StatusIds_UNAVAILABLE,
+			policies: map[Ydb.StatusIds_StatusCode]Policy{
+				Ydb.StatusIds_UNAVAILABLE: {Retryable: false},
+			},
+			attempts: 1,
+		},
+		{
+			name:     "DefaultNonRetryable",
+			code:     Ydb.StatusIds_BAD_REQUEST,
+			attempts: 1,
+		},
+		{
+			name: "OverrideToRetryable",
+			code: Ydb.StatusIds_BAD_REQUEST,
+			policies: map[Ydb.StatusIds_StatusCode]Policy{
+				Ydb.StatusIds_BAD_REQUEST: {Retryable: true, Backoff: &fastBackoff},
+			},
+			attempts: 3,
+		},
+		{
+			name: "OverrideToIdempotentOnly",
+			code: Ydb.StatusIds_UNAVAILABLE,
+			policies: map[Ydb.StatusIds_StatusCode]Policy{
+				Ydb.StatusIds_UNAVAILABLE: {Retryable: true, IdempotentOnly: true},
+			},
+			attempts: 1,
+		},
+		{
+			name: "OverrideToIdempotentOnlyWithIdempotentOperation",
+			code: Ydb.StatusIds_UNAVAILABLE,
+			policies: map[Ydb.StatusIds_StatusCode]Policy{
+				Ydb.StatusIds_UNAVAILABLE: {Retryable: true, IdempotentOnly: true},
+			},
+			idempotent: true,
+			attempts:   3,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			_ = Retry(context.Background(), func(ctx context.Context) error {
+				attempts++
+				if attempts < 3 {
+					return xerrors.Operation(xerrors.WithStatusCode(tt.code))
+				}
+
+				return nil
+			},
+				WithIdempotent(tt.idempotent),
+				WithPolicies(tt.policies),
+				WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Millisecond))),
+			)
+			require.Equal(t, tt.attempts, attempts)
+		})
+	}
+}
+
+func TestCheckWithPolicies(t *testing.T) {
+	var (
+		keep        = false
+		fastBackoff = TypeFastBackoff
+		err         = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION))
+	)
+	require.True(t, Check(err).IsRetryObjectValid())
+	m := Check(err, WithPolicies(map[Ydb.StatusIds_StatusCode]Policy{
+		Ydb.StatusIds_BAD_SESSION: {Retryable: true, Backoff: &fastBackoff, DeleteSession: &keep},
+	}))
+	require.False(t, m.IsRetryObjectValid())
+	require.Equal(t, TypeFastBackoff, m.BackoffType())
+	m = Check(err, WithPolicies(map[Ydb.StatusIds_StatusCode]Policy{
+		Ydb.StatusIds_BAD_SESSION: {Retryable: true},
+	}))
+	require.True(t, m.IsRetryObjectValid())
+}
+
+func TestCheckWithPoliciesDefaultBackoff(t *testing.T) {
+	for _, tt := range []struct {
+		code    Ydb.StatusIds_StatusCode
+		backoff backoff.Type
+	}{
+		{code: Ydb.StatusIds_OVERLOADED, backoff: TypeSlowBackoff},
+		{code: Ydb.StatusIds_UNAVAILABLE, backoff: TypeFastBackoff},
+		{code: Ydb.StatusIds_BAD_SESSION, backoff: TypeNoBackoff},
+		{code: Ydb.StatusIds_BAD_REQUEST, backoff: TypeFastBackoff},
+	} {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			m := Check(xerrors.Operation(xerrors.WithStatusCode(tt.code)),
+				WithPolicies(map[Ydb.StatusIds_StatusCode]Policy{
+					tt.code: {Retryable: true},
+				}),
+			)
+			require.True(t, m.MustRetry(true))
+			require.Equal(t, tt.backoff, m.BackoffType())
+		})
+	}
+}
@@ -3,7 +3,9 @@ package retry
 import (
 	"context"
 	"fmt"
-	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -27,6 +29,11 @@ type retryOptions struct {
 	fastBackoff backoff.Backoff
 	slowBackoff backoff.Backoff
 	budget      budget.Budget
+	policies    map[Ydb.StatusIds_StatusCode]Policy
+	breaker     *CircuitBreaker
+	maxAttempts int
+	clock       clockwork.Clock
+	onAttempt   []func(attempt int, err error)
 
 	panicCallback func(e interface{})
 }
@@ -222,6 +229,80 @@ func WithSlowBackoff(b backoff.Backoff) slowBackoffOption {
 	return slowBackoffOption{backoff: b}
 }
 
+var _ Option = maxAttemptsOption(0)
+
+type maxAttemptsOption int
+
+func (maxAttempts maxAttemptsOption) ApplyRetryOption(opts *retryOptions) {
+	opts.maxAttempts = int(maxAttempts)
+}
+
+func (maxAttempts maxAttemptsOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, maxAttempts)
+}
+
+func (maxAttempts maxAttemptsOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, maxAttempts)
+}
+
+// WithMaxAttempts limits number of attempts of retry operation.
+// If maxAttempts is less than or equal to zero then number of attempts is not limited.
+func WithMaxAttempts(maxAttempts int) maxAttemptsOption {
+	return maxAttemptsOption(maxAttempts)
+}
+
+var _ Option = clockOption{}
+
+type clockOption struct {
+	clock clockwork.Clock
+}
+
+func (o clockOption) ApplyRetryOption(opts *retryOptions) {
+	if o.clock != nil {
+		opts.clock = o.clock
+	}
+}
+
+func (o clockOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+func (o clockOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+// WithClock replaces clock used for backoff delays between retry attempts.
+// It allows to test retry logic deterministically with fake clock (e.g. clockwork.NewFakeClock())
+// without real sleeps.
+func WithClock(clock clockwork.Clock) clockOption {
+	return clockOption{clock: clock}
+}
+
+var _ Option = onAttemptOption(nil)
+
+type onAttemptOption func(attempt int, err error)
+
+func (onAttempt onAttemptOption) ApplyRetryOption(opts *retryOptions) {
+	if onAttempt != nil {
+		opts.onAttempt = append(opts.onAttempt, onAttempt)
+	}
+}
+
+func (onAttempt onAttemptOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, onAttempt)
+}
+
+func (onAttempt onAttemptOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, onAttempt)
+}
+
+// WithOnAttempt appends callback which is called after each attempt of retry operation
+// with attempt number (starting from 1) and error of attempt (nil on success).
+// Unlike trace.Retry it allows to emit domain-specific metrics without implementing trace structures.
+func WithOnAttempt(onAttempt func(attempt int, err error)) onAttemptOption {
+	return onAttempt
+}
+
 var _ Option = panicCallbackOption{}
 
 type panicCallbackOption struct {
@@ -264,6 +345,7 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 		call:        stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/retry.Retry"),
 		trace:       &trace.Retry{},
 		budget:      budget.Limited(-1),
+		clock:       clockwork.NewRealClock(),
 		fastBackoff: backoff.Fast,
 		slowBackoff: backoff.Slow,
 	}
@@ -306,13 +388,32 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 			)
 
 		default:
-			err := opWithRecover(ctx, options, op)
+			if options.breaker != nil {
+				if err := options.breaker.allow(options.trace); err != nil {
+					return xerrors.WithStackTrace(
+						fmt.Errorf("attempt No.%d: %w", attempts, err),
+					)
+				}
+			}
+
+			var err error
+			if options.breaker != nil {
+				err = options.breaker.call(ctx, options.trace, func() error {
+					return opWithRecover(ctx, options, op)
+				})
+			} else {
+				err = opWithRecover(ctx, options, op)
+			}
+
+			for _, onAttempt := range options.onAttempt {
+				onAttempt(attempts, err)
+			}
 
 			if err == nil {
 				return nil
 			}
 
-			m := Check(err)
+			m := Check(err).withPolicies(err, options.policies)
 
 			if m.StatusCode() != code {
 				i = 0
@@ -327,10 +428,27 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 				)
 			}
 
-			t := time.NewTimer(backoff.Delay(m.BackoffType(), i,
+			if options.maxAttempts > 0 && attempts >= options.maxAttempts {
+				return xerrors.WithStackTrace(
+					fmt.Errorf("max attempts limit (%d) exceeded: %w", options.maxAttempts, err),
+				)
+			}
+
+			delay := backoff.Delay(m.BackoffType(), i,
 				backoff.WithFastBackoff(options.fastBackoff),
 				backoff.WithSlowBackoff(options.slowBackoff),
-			))
+			)
+
+			if deadline, has := ctx.Deadline(); has && deadline.Sub(options.clock.Now()) < delay {
+				return xerrors.WithStackTrace(
+					xerrors.Join(
+						fmt.Errorf("attempt No.%d: %w", attempts, ErrWouldExceedDeadline),
+						err,
+					),
+				)
+			}
+
+			t := options.clock.NewTimer(delay)
 
 			select {
 			case <-ctx.Done():
@@ -342,7 +460,7 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 						err,
 					),
 				)
-			case <-t.C:
+			case <-t.Chan():
 				t.Stop()
 
 				if acquireErr := options.budget.Acquire(ctx); acquireErr != nil {
@@ -359,6 +477,35 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 	}
 }
 
+// RetryWithResult provide the best effort fo retrying operation which returns a result value
+//
+// RetryWithResult has the same retry semantics (idempotency, backoff, budget) as Retry
+// and returns the result of the successful op call, so callers don't need to capture
+// results through closure variables.
+func RetryWithResult[T any](ctx context.Context, op func(context.Context) (T, error), opts ...Option) (T, error) {
+	var result T
+
+	err := Retry(ctx, func(ctx context.Context) error {
+		v, err := op(ctx)
+		if err != nil {
+			return err
+		}
+
+		result = v
+
+		return nil
+	}, append([]Option{
+		withCaller(stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/retry.RetryWithResult")),
+	}, opts...)...)
+	if err != nil {
+		var zero T
+
+		return zero, xerrors.WithStackTrace(err)
+	}
+
+	return result, nil
+}
+
 func opWithRecover(ctx context.Context, options *retryOptions, op retryOperation) (err error) {
 	if options.panicCallback != nil {
 		defer func() {
@@ -375,13 +522,28 @@ func opWithRecover(ctx context.Context, options *retryOptions, op retryOperation
 }
 
 // Check returns retry mode for queryErr.
-func Check(err error) (m retryMode) {
+//
+// Retry policies from opts (see WithPolicies) override default retry mode, other options are ignored.
+func Check(err error, opts ...Option) (m retryMode) {
 	code, errType, backoffType, deleteSession := xerrors.Check(err)
 
-	return retryMode{
+	m = retryMode{
 		code:               code,
 		errType:            errType,
 		backoff:            backoffType,
 		isRetryObjectValid: deleteSession,
+		issues:             issuesFromProto(xerrors.Issues(err)),
+	}
+	if len(opts) == 0 {
+		return m
 	}
+
+	options := retryOptions{trace: &trace.Retry{}}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyRetryOption(&options)
+		}
+	}
+
+	return m.withPolicies(err, options.policies)
 }
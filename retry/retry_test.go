@@ -7,11 +7,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
@@ -210,6 +213,163 @@ func TestRetryWithBudget(t *testing.T) {
 	})
 }
 
+func TestRetryWithResult(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		attempts := 0
+		v, err := RetryWithResult(context.Background(), func(ctx context.Context) (int, error) {
+			attempts++
+			if attempts < 3 {
+				return 0, RetryableError(errors.New("custom error"))
+			}
+
+			return 42, nil
+		}, WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Millisecond))))
+		require.NoError(t, err)
+		require.Equal(t, 42, v)
+		require.Equal(t, 3, attempts)
+	})
+	t.Run("NonIdempotent", func(t *testing.T) {
+		attempts := 0
+		v, err := RetryWithResult(context.Background(), func(ctx context.Context) (string, error) {
+			attempts++
+
+			return "partial", xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNDETERMINED))
+		})
+		require.Error(t, err)
+		require.Empty(t, v)
+		require.Equal(t, 1, attempts)
+	})
+}
+
+func TestRetryStopsBeforeDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	attempts := 0
+	start := time.Now()
+	err := Retry(ctx, func(ctx context.Context) error {
+		attempts++
+
+		return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+	}, WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Minute))))
+	require.ErrorIs(t, err, ErrWouldExceedDeadline)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+	require.Equal(t, 1, attempts)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestRetryStopsBeforeDeadlineWithClock(t *testing.T) {
+	clock := clockwork.NewFakeClockAt(time.Now())
+	ctx, cancel := context.WithDeadline(context.Background(), clock.Now().Add(time.Hour))
+	defer cancel()
+	attempts := 0
+	done := make(chan error, 1)
+	go func() {
+		done <- Retry(ctx, func(ctx context.Context) error {
+			attempts++
+
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		},
+			WithClock(clock),
+			WithSlowBackoff(ConstantBackoff(10*time.Minute)),
+		)
+	}()
+	// first backoff fits into deadline by clock, second one is not
+	clock.BlockUntil(1)
+	clock.Advance(55 * time.Minute)
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, ErrWouldExceedDeadline)
+		require.Equal(t, 2, attempts)
+	case <-time.After(time.Second):
+		t.Fatal("deadline is not checked with clock")
+	}
+}
+
+func TestRetryWithMaxAttempts(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		return RetryableError(errors.New("custom error"), WithBackoff(TypeNoBackoff))
+	}, WithMaxAttempts(3))
+	require.Error(t, err)
+	require.Equal(t, 3, attempts)
+}
+
+func TestCheckIssues(t *testing.T) {
+	m := Check(xerrors.WithStackTrace(xerrors.Operation(
+		xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR),
+		xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+			Message:   "Type annotation",
+			IssueCode: 1030,
+			Severity:  1,
+			Issues: []*Ydb_Issue.IssueMessage{{
+				Message:   "Cannot find table 'db.[/local/test]'.",
+				IssueCode: 2003,
+				Severity:  1,
+			}},
+		}}),
+	)))
+	require.Equal(t, Issues{{
+		Message:  "Type annotation",
+		Code:     1030,
+		Severity: 1,
+		Issues: Issues{{
+			Message:  "Cannot find table 'db.[/local/test]'.",
+			Code:     2003,
+			Severity: 1,
+		}},
+	}}, m.Issues())
+	require.Equal(t, "[{#1030 'Type annotation' [{#2003 'Cannot find table 'db.[/local/test]''}]}]", m.Issues().String())
+	require.Empty(t, Check(errors.New("custom error")).Issues())
+}
+
+func TestRetryWithClock(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	attempts := 0
+	done := make(chan error)
+	go func() {
+		done <- Retry(context.Background(), func(ctx context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+			}
+
+			return nil
+		},
+			WithClock(clock),
+			WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Hour))),
+		)
+	}()
+	for i := 0; i < 2; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Hour * 100)
+	}
+	require.NoError(t, <-done)
+	require.Equal(t, 3, attempts)
+}
+
+func TestRetryWithOnAttempt(t *testing.T) {
+	var (
+		attempts []int
+		errs     []error
+		opErr    = RetryableError(errors.New("custom error"), WithBackoff(TypeNoBackoff))
+	)
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		if len(attempts) < 2 {
+			return opErr
+		}
+
+		return nil
+	}, WithOnAttempt(func(attempt int, err error) {
+		attempts = append(attempts, attempt)
+		errs = append(errs, err)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, attempts)
+	require.Equal(t, []error{opErr, opErr, nil}, errs)
+}
+
 type MockPanicCallback struct {
 	called   bool
 	received interface{}
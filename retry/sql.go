@@ -41,6 +41,9 @@ func WithDoRetryOptions(opts ...Option) doRetryOptionsOption {
 }
 
 // Do is a retryer of database/sql Conn with fallbacks on errors
+//
+// Errors of op are classified with the same rules as in Retry. Each attempt takes connection
+// from db pool, so connections with broken sessions are replaced by new ones on retries.
 func Do(ctx context.Context, db *sql.DB, op func(ctx context.Context, cc *sql.Conn) error, opts ...doOption) error {
 	var (
 		options = doOptions{
@@ -50,12 +53,14 @@ func Do(ctx context.Context, db *sql.DB, op func(ctx context.Context, cc *sql.Co
 		}
 		attempts = 0
 	)
-	if tracer, has := db.Driver().(interface {
+	if d, has := db.Driver().(interface {
 		TraceRetry() *trace.Retry
+		RetryBudget() budget.Budget
 	}); has {
-		options.retryOptions = append(options.retryOptions, nil)
-		copy(options.retryOptions[1:], options.retryOptions)
-		options.retryOptions[0] = WithTrace(tracer.TraceRetry())
+		options.retryOptions = append(options.retryOptions, nil, nil)
+		copy(options.retryOptions[2:], options.retryOptions)
+		options.retryOptions[0] = WithTrace(d.TraceRetry())
+		options.retryOptions[1] = WithBudget(d.RetryBudget())
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -130,6 +135,9 @@ func WithTxOptions(txOptions *sql.TxOptions) txOptionsOption {
 }
 
 // DoTx is a retryer of database/sql transactions with fallbacks on errors
+//
+// Transaction is begun on each attempt (with options from WithTxOptions) and committed
+// after successful op. Errors are classified with the same rules as in Retry.
 func DoTx(ctx context.Context, db *sql.DB, op func(context.Context, *sql.Tx) error, opts ...doTxOption) error {
 	var (
 		options = doTxOptions{
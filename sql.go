@@ -90,6 +90,9 @@ const (
 	ScriptingQueryMode = xsql.ScriptingQueryMode
 )
 
+// WithQueryMode makes context for database/sql calls executed in specified query mode
+// instead of default (data queries or mode from WithDefaultQueryMode), so one *sql.DB can run
+// data, scan, scheme, scripting and explain queries. Scan query results are streamed through sql.Rows.
 func WithQueryMode(ctx context.Context, mode QueryMode) context.Context {
 	return xsql.WithQueryMode(ctx, mode)
 }
@@ -117,14 +120,19 @@ func WithTablePathPrefix(tablePathPrefix string) QueryBindConnectorOption {
 	return xsql.WithTablePathPrefix(tablePathPrefix)
 }
 
+// WithAutoDeclare enables generation of DECLARE section for query parameters from types of args
 func WithAutoDeclare() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.AutoDeclare{})
 }
 
+// WithPositionalArgs enables rewriting of `?` placeholders into named parameters $p0, $p1, ...
+//
+// Together with WithAutoDeclare standard Go SQL code with `?` placeholders runs unchanged.
 func WithPositionalArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.PositionalArgs{})
 }
 
+// WithNumericArgs enables rewriting of `$1`, `$2`, ... placeholders into named parameters $p0, $p1, ...
 func WithNumericArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.NumericArgs{})
 }
@@ -158,6 +166,9 @@ type SQLConnector interface {
 	Close() error
 }
 
+// Connector makes database/sql connector on top of already opened native driver, so
+// credentials, balancing and traces of parent are reused by sql.OpenDB(connector).
+// Closing of connector doesn't close parent driver.
 func Connector(parent *Driver, opts ...ConnectorOption) (SQLConnector, error) {
 	c, err := xsql.Open(parent,
 		append(
@@ -178,6 +189,7 @@ func Connector(parent *Driver, opts ...ConnectorOption) (SQLConnector, error) {
 	return c, nil
 }
 
+// MustConnector is like Connector but panics on error
 func MustConnector(parent *Driver, opts ...ConnectorOption) SQLConnector {
 	c, err := Connector(parent, opts...)
 	if err != nil {
@@ -2,6 +2,7 @@ package sugar
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/helpers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -17,6 +18,50 @@ func IsTableExists(ctx context.Context, c scheme.Client, absTablePath string) (e
 	return exists, nil
 }
 
+// TableExistence is a result of CheckTableExists
+type TableExistence int
+
+const (
+	// TableNotExists means that there is no entry with table path
+	TableNotExists TableExistence = iota
+	// TableExists means that row or column table exists with table path
+	TableExists
+	// TableExistsAsOtherEntry means that table path is occupied by another scheme entry (directory, topic, etc.)
+	TableExistsAsOtherEntry
+)
+
+func (e TableExistence) String() string {
+	switch e {
+	case TableNotExists:
+		return "not exists"
+	case TableExists:
+		return "exists"
+	case TableExistsAsOtherEntry:
+		return "exists as other entry"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(e))
+	}
+}
+
+// CheckTableExists checks existence of row or column table with absTablePath
+//
+// Unlike IsTableExists, CheckTableExists does not return error if absTablePath
+// is occupied by entry of another type and returns TableExistsAsOtherEntry instead.
+func CheckTableExists(ctx context.Context, c scheme.Client, absTablePath string) (TableExistence, error) {
+	entryType, exists, err := helpers.EntryType(ctx, c, absTablePath)
+	if err != nil {
+		return TableNotExists, xerrors.WithStackTrace(err)
+	}
+	switch {
+	case !exists:
+		return TableNotExists, nil
+	case entryType == scheme.EntryTable || entryType == scheme.EntryColumnTable:
+		return TableExists, nil
+	default:
+		return TableExistsAsOtherEntry, nil
+	}
+}
+
 func IsColumnTableExists(ctx context.Context, c scheme.Client, absTablePath string) (exists bool, _ error) {
 	exists, err := helpers.IsEntryExists(ctx, c, absTablePath, scheme.EntryColumnTable)
 	if err != nil {
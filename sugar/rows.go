@@ -0,0 +1,84 @@
+package sugar
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	tableResult "github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// RowsIterator iterates over rows of all result sets of result with decoding of each row into struct T
+type RowsIterator[T any] struct {
+	ctx     context.Context //nolint:containedctx
+	res     tableResult.BaseResult
+	columns map[string]types.Type
+	row     T
+	err     error
+}
+
+// Rows makes iterator over rows of result with decoding of each row into struct T
+//
+// Rows of current (if any) and all remaining result sets are iterated, so Rows is suitable for streaming results
+// (such as results of StreamExecuteScanQuery and StreamReadTable) which are split into many result sets.
+// Struct fields are mapped to result columns same as in UnmarshalRow.
+//
+//	it := sugar.Rows[series](ctx, res)
+//	for it.Next() {
+//		s := it.Row()
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Rows[T any](ctx context.Context, res tableResult.BaseResult) *RowsIterator[T] {
+	it := &RowsIterator[T]{
+		ctx: ctx,
+		res: res,
+	}
+	if t := reflect.TypeOf(it.row); t == nil || t.Kind() != reflect.Struct {
+		it.err = xerrors.WithStackTrace(fmt.Errorf("%w: %v", errDstIsNotAPointerToStruct, t))
+	}
+	if res.CurrentResultSet().ColumnCount() > 0 {
+		it.columns = currentColumns(res)
+	}
+
+	return it
+}
+
+// Next decodes next row and reports whether row is available
+func (it *RowsIterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for it.columns == nil || !it.res.NextRow() {
+		if !it.res.NextResultSet(it.ctx) {
+			it.err = it.res.Err()
+
+			return false
+		}
+		it.columns = currentColumns(it.res)
+	}
+	var row T
+	if err := unmarshalRow(it.res, it.columns, reflect.ValueOf(&row).Elem()); err != nil {
+		it.err = xerrors.WithStackTrace(err)
+
+		return false
+	}
+	it.row = row
+
+	return true
+}
+
+// Row returns current decoded row
+func (it *RowsIterator[T]) Row() T {
+	return it.row
+}
+
+// Err returns error of iteration
+func (it *RowsIterator[T]) Err() error {
+	return it.err
+}
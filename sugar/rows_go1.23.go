@@ -0,0 +1,34 @@
+//go:build go1.23
+
+package sugar
+
+import (
+	"context"
+	"iter"
+
+	tableResult "github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+// RowsSeq returns sequence of rows of result decoded into struct T for use in range loop
+//
+//	for s, err := range sugar.RowsSeq[series](ctx, res) {
+//		if err != nil {
+//			return err
+//		}
+//	}
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func RowsSeq[T any](ctx context.Context, res tableResult.BaseResult) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		it := Rows[T](ctx, res)
+		for it.Next() {
+			if !yield(it.Row(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+//go:build go1.23
+
+package sugar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+)
+
+func TestRowsSeq(t *testing.T) {
+	res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult()}, nil)
+	var ids []uint64
+	for row, err := range RowsSeq[testRow](context.Background(), res) {
+		require.NoError(t, err)
+		ids = append(ids, row.ID)
+	}
+	require.Equal(t, []uint64{1, 2}, ids)
+}
@@ -0,0 +1,49 @@
+package sugar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+)
+
+func TestRows(t *testing.T) {
+	comment := "c"
+	exp := []testRow{
+		{ID: 1, Title: "a"},
+		{ID: 2, Comment: &comment},
+		{ID: 1, Title: "a"},
+		{ID: 2, Comment: &comment},
+	}
+	t.Run("AllResultSets", func(t *testing.T) {
+		res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult(), newTestResult()}, nil)
+		var rows []testRow
+		it := Rows[testRow](context.Background(), res)
+		for it.Next() {
+			rows = append(rows, it.Row())
+		}
+		require.NoError(t, it.Err())
+		require.Equal(t, exp, rows)
+	})
+	t.Run("CurrentResultSet", func(t *testing.T) {
+		res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult(), newTestResult()}, nil)
+		require.True(t, res.NextResultSet(context.Background()))
+		require.True(t, res.NextRow())
+		var rows []testRow
+		it := Rows[testRow](context.Background(), res)
+		for it.Next() {
+			rows = append(rows, it.Row())
+		}
+		require.NoError(t, it.Err())
+		require.Equal(t, exp[1:], rows)
+	})
+	t.Run("NotAStruct", func(t *testing.T) {
+		res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult()}, nil)
+		it := Rows[int](context.Background(), res)
+		require.False(t, it.Next())
+		require.ErrorIs(t, it.Err(), errDstIsNotAPointerToStruct)
+	})
+}
@@ -0,0 +1,126 @@
+package sugar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const defaultTruncateTableBatchSize = 1000
+
+type (
+	truncateTableOptions struct {
+		batchSize  uint64
+		onProgress func(deleted uint64)
+	}
+	TruncateTableOption func(opts *truncateTableOptions)
+)
+
+// WithTruncateTableBatchSize defines max number of rows deleted in single transaction
+//
+// Default batch size is 1000 rows.
+func WithTruncateTableBatchSize(batchSize uint64) TruncateTableOption {
+	return func(opts *truncateTableOptions) {
+		if batchSize > 0 {
+			opts.batchSize = batchSize
+		}
+	}
+}
+
+// WithTruncateTableProgress defines callback which called after each deleted batch
+// with total number of deleted rows
+func WithTruncateTableProgress(onProgress func(deleted uint64)) TruncateTableOption {
+	return func(opts *truncateTableOptions) {
+		opts.onProgress = onProgress
+	}
+}
+
+// TruncateTable deletes all rows of table with absTablePath
+//
+// TruncateTable deletes rows by batches of primary keys, each batch in separate
+// transaction, so table schema, indexes, changefeeds and other settings stay untouched.
+// If TruncateTable interrupted, some rows may be already deleted.
+func TruncateTable(ctx context.Context, c table.Client, absTablePath string, opts ...TruncateTableOption) error {
+	options := truncateTableOptions{
+		batchSize: defaultTruncateTableBatchSize,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	var primaryKey []string
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) error {
+		desc, err := s.DescribeTable(ctx, absTablePath)
+		if err != nil {
+			return err
+		}
+		primaryKey = desc.PrimaryKey
+
+		return nil
+	}, table.WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	keyColumns := make([]string, len(primaryKey))
+	for i, column := range primaryKey {
+		keyColumns[i] = xstring.QuoteIdentifier(column)
+	}
+	query := fmt.Sprintf(`
+		DECLARE $limit AS Uint64;
+		$keys = SELECT %[2]s FROM %[1]s LIMIT $limit;
+		SELECT COUNT(*) AS deleted FROM $keys;
+		DELETE FROM %[1]s ON SELECT * FROM $keys;
+	`, xstring.QuoteIdentifier(absTablePath), strings.Join(keyColumns, ", "))
+
+	var total uint64
+	for {
+		var deleted uint64
+		err = c.Do(ctx, func(ctx context.Context, s table.Session) error {
+			deleted = 0 // count of previous failed attempt is not actual
+
+			_, res, err := s.Execute(ctx, table.SerializableReadWriteTxControl(table.CommitTx()), query,
+				table.NewQueryParameters(
+					table.ValueParam("$limit", types.Uint64Value(options.batchSize)),
+				),
+			)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = res.Close()
+			}()
+			if err = res.NextResultSetErr(ctx); err != nil {
+				return err
+			}
+			if res.NextRow() {
+				if err = res.ScanNamed(named.Required("deleted", &deleted)); err != nil {
+					return err
+				}
+			}
+
+			return res.Err()
+		}, table.WithIdempotent())
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if deleted == 0 {
+			return nil
+		}
+		total += deleted
+		if options.onProgress != nil {
+			options.onProgress(total)
+		}
+		if deleted < options.batchSize {
+			return nil
+		}
+	}
+}
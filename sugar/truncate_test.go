@@ -0,0 +1,135 @@
+package sugar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	tableResult "github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+// truncateTestClient emulates table with rows count of rows, rows are deleted only by
+// committed attempts. The first attempt of each retry operation fails if failAttempts is set.
+type truncateTestClient struct {
+	table.Client
+
+	primaryKey   []string
+	rows         uint64
+	failAttempts bool
+	queries      []string
+	limits       []uint64
+}
+
+func (c *truncateTestClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	if c.failAttempts {
+		_ = op(ctx, &truncateTestSession{c: c, rollback: true})
+	}
+
+	return op(ctx, &truncateTestSession{c: c})
+}
+
+type truncateTestSession struct {
+	table.Session
+
+	c        *truncateTestClient
+	rollback bool
+}
+
+func (s *truncateTestSession) DescribeTable(
+	context.Context, string, ...options.DescribeTableOption,
+) (options.Description, error) {
+	return options.Description{PrimaryKey: s.c.primaryKey}, nil
+}
+
+func (s *truncateTestSession) Execute(
+	ctx context.Context, tx *table.TransactionControl, query string, params *params.Parameters,
+	opts ...options.ExecuteDataQueryOption,
+) (table.Transaction, tableResult.Result, error) {
+	var limit uint64
+	params.Each(func(name string, v value.Value) {
+		if name == "$limit" {
+			_ = value.CastTo(v, &limit)
+		}
+	})
+	s.c.queries = append(s.c.queries, query)
+	s.c.limits = append(s.c.limits, limit)
+	deleted := min(limit, s.c.rows)
+	if !s.rollback {
+		s.c.rows -= deleted
+	}
+
+	return nil, scanner.NewUnary([]*Ydb.ResultSet{{
+		Columns: []*Ydb.Column{{
+			Name: "deleted",
+			Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+		}},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: deleted}}},
+		}},
+	}}, nil), nil
+}
+
+func TestTruncateTable(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		rows         uint64
+		failAttempts bool
+		progress     []uint64
+	}{
+		{
+			name:     "Batches",
+			rows:     7,
+			progress: []uint64{3, 6, 7},
+		},
+		{
+			name:     "FullLastBatch",
+			rows:     6,
+			progress: []uint64{3, 6},
+		},
+		{
+			name: "Empty",
+		},
+		{
+			name:         "Retries",
+			rows:         5,
+			failAttempts: true,
+			progress:     []uint64{3, 5},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &truncateTestClient{
+				primaryKey:   []string{"id"},
+				rows:         tt.rows,
+				failAttempts: tt.failAttempts,
+			}
+			var progress []uint64
+			err := TruncateTable(context.Background(), c, "/local/series",
+				WithTruncateTableBatchSize(3),
+				WithTruncateTableProgress(func(deleted uint64) {
+					progress = append(progress, deleted)
+				}),
+			)
+			require.NoError(t, err)
+			require.Zero(t, c.rows)
+			require.Equal(t, tt.progress, progress)
+			for _, limit := range c.limits {
+				require.Equal(t, uint64(3), limit)
+			}
+		})
+	}
+}
+
+func TestTruncateTableQuoting(t *testing.T) {
+	c := &truncateTestClient{primaryKey: []string{"id", "we`ird"}}
+	require.NoError(t, TruncateTable(context.Background(), c, "/local/my`series"))
+	require.Len(t, c.queries, 1)
+	require.Contains(t, c.queries[0], "SELECT `id`, `we\\`ird` FROM `/local/my\\`series` LIMIT $limit;")
+	require.Contains(t, c.queries[0], "DELETE FROM `/local/my\\`series` ON SELECT * FROM $keys;")
+}
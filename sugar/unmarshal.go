@@ -0,0 +1,116 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	tableResult "github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var (
+	errDstIsNotAPointerToStruct = errors.New("destination is not a pointer to struct")
+	errDstIsNotAPointerToSlice  = errors.New("destination is not a pointer to slice of structs")
+)
+
+// UnmarshalRow scans current row of result into struct pointed by dst
+//
+// Struct fields are mapped to result columns by `ydb` tag or by field name if tag is not defined.
+// Type part of tag (`ydb:"name,Type"` as in table.ParamsFromStruct) is ignored.
+// Fields with tag `ydb:"-"`, unexported fields and fields without column in result set are skipped.
+// NULL values of optional columns are scanned as nil into pointer fields and as zero values into other fields.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UnmarshalRow(res tableResult.BaseResult, dst interface{}) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Struct {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T", errDstIsNotAPointerToStruct, dst))
+	}
+
+	return unmarshalRow(res, currentColumns(res), ptr.Elem())
+}
+
+// UnmarshalRows scans all remaining rows of current result set into slice pointed by dst
+//
+// Items of slice must be structs or pointers to structs, rows are appended to slice.
+// Struct fields are mapped to result columns same as in UnmarshalRow.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UnmarshalRows(res tableResult.BaseResult, dst interface{}) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Slice {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T", errDstIsNotAPointerToSlice, dst))
+	}
+	var (
+		slice    = ptr.Elem()
+		itemType = slice.Type().Elem()
+		isPtr    = itemType.Kind() == reflect.Pointer
+	)
+	if isPtr {
+		itemType = itemType.Elem()
+	}
+	if itemType.Kind() != reflect.Struct {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T", errDstIsNotAPointerToSlice, dst))
+	}
+	columns := currentColumns(res)
+	for res.NextRow() {
+		item := reflect.New(itemType)
+		if err := unmarshalRow(res, columns, item.Elem()); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if isPtr {
+			slice.Set(reflect.Append(slice, item))
+		} else {
+			slice.Set(reflect.Append(slice, item.Elem()))
+		}
+	}
+
+	return res.Err()
+}
+
+func currentColumns(res tableResult.BaseResult) map[string]types.Type {
+	columns := make(map[string]types.Type)
+	for _, c := range res.Columns() {
+		columns[c.Name] = c.Type
+	}
+
+	return columns
+}
+
+// fieldColumnName returns name of column for struct field or false if field must be skipped
+func fieldColumnName(f reflect.StructField) (string, bool) {
+	name, _, ok := value.StructFieldName(f)
+
+	return name, ok
+}
+
+func unmarshalRow(res tableResult.BaseResult, columns map[string]types.Type, v reflect.Value) error {
+	tt := v.Type()
+	values := make([]named.Value, 0, tt.NumField())
+	for i := 0; i < tt.NumField(); i++ {
+		f := tt.Field(i)
+		name, ok := fieldColumnName(f)
+		if !ok {
+			continue
+		}
+		t, has := columns[name]
+		if !has {
+			continue
+		}
+		dst := v.Field(i).Addr().Interface()
+		switch isOptional, _ := types.IsOptional(t); {
+		case !isOptional:
+			values = append(values, named.Required(name, dst))
+		case f.Type.Kind() == reflect.Pointer:
+			values = append(values, named.Optional(name, dst))
+		default:
+			values = append(values, named.OptionalWithDefault(name, dst))
+		}
+	}
+
+	return res.ScanNamed(values...)
+}
@@ -0,0 +1,202 @@
+package sugar
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var (
+	typeOfSQLScanner = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	typeOfYDBValue   = reflect.TypeOf((*types.Value)(nil)).Elem()
+	typeOfDecimal    = reflect.TypeOf(types.Decimal{})
+	typeOfBytes      = reflect.TypeOf([]byte(nil))
+)
+
+type sqlColumn struct {
+	name string
+	json bool
+}
+
+// UnmarshalSQLRow scans current row of rows from ydb database/sql driver into struct pointed by dst
+//
+// Struct fields are mapped to columns same as in UnmarshalRow. Unlike rows.Scan, YDB specific values
+// keep their types: Decimal values are scanned into types.Decimal or types.Value fields, Json and
+// JsonDocument values are decoded into structs, maps, slices and json.Unmarshaler fields, NULL values
+// of optional columns are scanned as nil into pointer fields and as zero values into other fields.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UnmarshalSQLRow(rows *sql.Rows, dst interface{}) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Struct {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T", errDstIsNotAPointerToStruct, dst))
+	}
+	columns, err := sqlColumns(rows)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return unmarshalSQLRow(rows, columns, ptr.Elem())
+}
+
+// UnmarshalSQLRows scans all remaining rows of rows from ydb database/sql driver into slice pointed by dst
+//
+// Items of slice must be structs or pointers to structs, rows are appended to slice.
+// Rows are scanned same as in UnmarshalSQLRow. Rows are not closed.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UnmarshalSQLRows(rows *sql.Rows, dst interface{}) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.Elem().Kind() != reflect.Slice {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T", errDstIsNotAPointerToSlice, dst))
+	}
+	var (
+		slice    = ptr.Elem()
+		itemType = slice.Type().Elem()
+		isPtr    = itemType.Kind() == reflect.Pointer
+	)
+	if isPtr {
+		itemType = itemType.Elem()
+	}
+	if itemType.Kind() != reflect.Struct {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T", errDstIsNotAPointerToSlice, dst))
+	}
+	columns, err := sqlColumns(rows)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	for rows.Next() {
+		item := reflect.New(itemType)
+		if err := unmarshalSQLRow(rows, columns, item.Elem()); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if isPtr {
+			slice.Set(reflect.Append(slice, item))
+		} else {
+			slice.Set(reflect.Append(slice, item.Elem()))
+		}
+	}
+
+	return xerrors.WithStackTrace(rows.Err())
+}
+
+func sqlColumns(rows *sql.Rows) ([]sqlColumn, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	columns := make([]sqlColumn, len(columnTypes))
+	for i, t := range columnTypes {
+		typeName := strings.TrimSuffix(strings.TrimPrefix(t.DatabaseTypeName(), "Optional<"), ">")
+		columns[i] = sqlColumn{
+			name: t.Name(),
+			json: typeName == "Json" || typeName == "JsonDocument",
+		}
+	}
+
+	return columns, nil
+}
+
+func unmarshalSQLRow(rows *sql.Rows, columns []sqlColumn, v reflect.Value) error {
+	values := make([]interface{}, len(columns))
+	dst := make([]interface{}, len(columns))
+	for i := range values {
+		dst[i] = &values[i]
+	}
+	if err := rows.Scan(dst...); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	fields := make(map[string]int, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		if name, ok := fieldColumnName(v.Type().Field(i)); ok {
+			fields[name] = i
+		}
+	}
+	for i, c := range columns {
+		fieldIndex, has := fields[c.name]
+		if !has {
+			continue
+		}
+		if err := assignSQLValue(v.Field(fieldIndex), values[i], c.json); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("column %q: %w", c.name, err))
+		}
+	}
+
+	return nil
+}
+
+// assignSQLValue sets src value scanned from database/sql row into dst
+//
+//nolint:gocyclo
+func assignSQLValue(dst reflect.Value, src interface{}, isJSON bool) error {
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+
+		return nil
+	}
+	if dst.Type() != typeOfYDBValue && dst.Addr().Type().Implements(typeOfSQLScanner) {
+		return xerrors.WithStackTrace(dst.Addr().Interface().(sql.Scanner).Scan(src)) //nolint:forcetypeassert
+	}
+	if dst.Kind() == reflect.Pointer {
+		item := reflect.New(dst.Type().Elem())
+		if err := assignSQLValue(item.Elem(), src, isJSON); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		dst.Set(item)
+
+		return nil
+	}
+	if v, ok := src.(types.Value); ok {
+		switch dst.Type() {
+		case typeOfYDBValue:
+			dst.Set(reflect.ValueOf(v))
+
+			return nil
+		case typeOfDecimal:
+			d, err := types.ToDecimal(v)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			dst.Set(reflect.ValueOf(*d))
+
+			return nil
+		default:
+			return xerrors.WithStackTrace(types.CastTo(v, dst.Addr().Interface()))
+		}
+	}
+	if b, ok := src.([]byte); ok && isJSON && dst.Type() != typeOfBytes && dst.Kind() != reflect.String {
+		return xerrors.WithStackTrace(json.Unmarshal(b, dst.Addr().Interface()))
+	}
+	sv := reflect.ValueOf(src)
+	switch {
+	case sv.Type().AssignableTo(dst.Type()):
+		dst.Set(sv)
+	case isSQLConvertible(sv.Type(), dst.Type()):
+		dst.Set(sv.Convert(dst.Type()))
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("cannot assign %T to %s", src, dst.Type()))
+	}
+
+	return nil
+}
+
+// isSQLConvertible reports whether value of type from can be converted to type to without
+// change of meaning: numbers to numbers and strings to bytes and vice versa
+func isSQLConvertible(from, to reflect.Type) bool {
+	if !from.ConvertibleTo(to) {
+		return false
+	}
+	isNumber := func(k reflect.Kind) bool {
+		return k >= reflect.Int && k <= reflect.Float64
+	}
+	isText := func(t reflect.Type) bool {
+		return t.Kind() == reflect.String || t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+	}
+
+	return isNumber(from.Kind()) && isNumber(to.Kind()) || isText(from) && isText(to)
+}
@@ -0,0 +1,119 @@
+package sugar
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// sqlTestDriver returns fixed rows on any query like ydb database/sql driver
+type sqlTestDriver struct {
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+}
+
+func (d *sqlTestDriver) Open(string) (driver.Conn, error) {
+	return &sqlTestConn{d: d}, nil
+}
+
+type sqlTestConnector struct {
+	d *sqlTestDriver
+}
+
+func (c *sqlTestConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.d.Open("")
+}
+
+func (c *sqlTestConnector) Driver() driver.Driver {
+	return c.d
+}
+
+type sqlTestConn struct {
+	driver.Conn
+
+	d *sqlTestDriver
+}
+
+func (c *sqlTestConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return &sqlTestRows{d: c.d}, nil
+}
+
+func (c *sqlTestConn) Close() error {
+	return nil
+}
+
+type sqlTestRows struct {
+	d   *sqlTestDriver
+	row int
+}
+
+func (r *sqlTestRows) Columns() []string {
+	return r.d.columns
+}
+
+func (r *sqlTestRows) ColumnTypeDatabaseTypeName(index int) string {
+	return r.d.types[index]
+}
+
+func (r *sqlTestRows) Close() error {
+	return nil
+}
+
+func (r *sqlTestRows) Next(dst []driver.Value) error {
+	if r.row >= len(r.d.rows) {
+		return io.EOF
+	}
+	copy(dst, r.d.rows[r.row])
+	r.row++
+
+	return nil
+}
+
+func TestUnmarshalSQLRows(t *testing.T) {
+	price := types.DecimalValueFromBigInt(big.NewInt(12345), 22, 2)
+	db := sql.OpenDB(&sqlTestConnector{d: &sqlTestDriver{
+		columns: []string{"id", "title", "price", "raw_price", "info", "comment"},
+		types:   []string{"Uint64", "Utf8", "Decimal(22,2)", "Decimal(22,2)", "Optional<Json>", "Optional<Utf8>"},
+		rows: [][]driver.Value{
+			{uint64(1), "IT Crowd", price, price, []byte(`{"seasons":4}`), "good"},
+			{uint64(2), "Silicon Valley", price, price, nil, nil},
+		},
+	}})
+	defer db.Close()
+
+	type info struct {
+		Seasons int `json:"seasons"`
+	}
+	type series struct {
+		ID      uint64        `ydb:"id"`
+		Title   string        `ydb:"title"`
+		Price   types.Decimal `ydb:"price"`
+		Value   types.Value   `ydb:"raw_price"`
+		Info    *info         `ydb:"info"`
+		Comment *string       `ydb:"comment"`
+	}
+	rows, err := db.QueryContext(context.Background(), "SELECT ...")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var dst []series
+	require.NoError(t, UnmarshalSQLRows(rows, &dst))
+	require.Len(t, dst, 2)
+	require.Equal(t, uint64(1), dst[0].ID)
+	require.Equal(t, "IT Crowd", dst[0].Title)
+	require.Equal(t, "123.45", dst[0].Price.String())
+	require.Equal(t, price, dst[0].Value)
+	require.Equal(t, &info{Seasons: 4}, dst[0].Info)
+	require.Equal(t, "good", *dst[0].Comment)
+	require.Equal(t, "Silicon Valley", dst[1].Title)
+	require.Nil(t, dst[1].Info)
+	require.Nil(t, dst[1].Comment)
+}
@@ -0,0 +1,117 @@
+package sugar
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func newTestResult() *Ydb.ResultSet {
+	optionalText := &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+		Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+	}}}
+
+	return &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "id", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}},
+			{Name: "title", Type: optionalText},
+			{Name: "comment", Type: optionalText},
+		},
+		Rows: []*Ydb.Value{
+			{Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}},
+				{Value: &Ydb.Value_TextValue{TextValue: "a"}},
+				{Value: &Ydb.Value_NullFlagValue{}},
+			}},
+			{Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: 2}},
+				{Value: &Ydb.Value_NullFlagValue{}},
+				{Value: &Ydb.Value_TextValue{TextValue: "c"}},
+			}},
+		},
+	}
+}
+
+type testRow struct {
+	ID      uint64  `ydb:"id"`
+	Title   string  `ydb:"title"`
+	Comment *string `ydb:"comment"`
+	Skipped string  `ydb:"-"`
+	Missing int
+}
+
+func TestUnmarshalRow(t *testing.T) {
+	res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult()}, nil)
+	require.True(t, res.NextResultSet(context.Background()))
+	require.True(t, res.NextRow())
+	var row testRow
+	require.NoError(t, UnmarshalRow(res, &row))
+	require.Equal(t, testRow{ID: 1, Title: "a"}, row)
+	require.ErrorIs(t, UnmarshalRow(res, row), errDstIsNotAPointerToStruct)
+}
+
+func TestUnmarshalRowTypedTag(t *testing.T) {
+	type series struct {
+		ID      uint64    `ydb:"id"`
+		Created time.Time `ydb:"created,Date"`
+	}
+	src := series{ID: 1, Created: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)}
+	params, err := table.ParamsFromStruct(src)
+	require.NoError(t, err)
+
+	a := allocator.New()
+	defer a.Free()
+	set := &Ydb.ResultSet{Rows: []*Ydb.Value{{}}}
+	params.Each(func(name string, v value.Value) {
+		tv := value.ToYDB(v, a)
+		set.Columns = append(set.Columns, &Ydb.Column{Name: strings.TrimPrefix(name, "$"), Type: tv.GetType()})
+		set.Rows[0].Items = append(set.Rows[0].Items, tv.GetValue())
+	})
+	require.Equal(t, Ydb.Type_DATE, set.GetColumns()[1].GetType().GetTypeId())
+
+	res := scanner.NewUnary([]*Ydb.ResultSet{set}, nil)
+	require.True(t, res.NextResultSet(context.Background()))
+	require.True(t, res.NextRow())
+	var dst series
+	require.NoError(t, UnmarshalRow(res, &dst))
+	require.Equal(t, src.ID, dst.ID)
+	require.True(t, src.Created.Equal(dst.Created))
+}
+
+func TestUnmarshalRows(t *testing.T) {
+	comment := "c"
+	exp := []testRow{
+		{ID: 1, Title: "a"},
+		{ID: 2, Comment: &comment},
+	}
+	t.Run("Structs", func(t *testing.T) {
+		res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult()}, nil)
+		require.True(t, res.NextResultSet(context.Background()))
+		var rows []testRow
+		require.NoError(t, UnmarshalRows(res, &rows))
+		require.Equal(t, exp, rows)
+	})
+	t.Run("Pointers", func(t *testing.T) {
+		res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult()}, nil)
+		require.True(t, res.NextResultSet(context.Background()))
+		var rows []*testRow
+		require.NoError(t, UnmarshalRows(res, &rows))
+		require.Len(t, rows, 2)
+		require.Equal(t, exp[0], *rows[0])
+		require.Equal(t, exp[1], *rows[1])
+	})
+	t.Run("NotASlice", func(t *testing.T) {
+		res := scanner.NewUnary([]*Ydb.ResultSet{newTestResult()}, nil)
+		var rows []int
+		require.ErrorIs(t, UnmarshalRows(res, &rows), errDstIsNotAPointerToSlice)
+	})
+}
@@ -0,0 +1,9 @@
+package table
+
+import (
+	"errors"
+)
+
+// ErrSessionPoolQueueOverflow returned by Client when session pool is exhausted
+// and number of callers waiting for session reached the wait queue limit
+var ErrSessionPoolQueueOverflow = errors.New("session pool wait queue overflow")
@@ -434,3 +434,250 @@ func Example_copyTables() {
 		fmt.Printf("unexpected error: %v", err)
 	}
 }
+
+func Example_renameTables() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	// atomic swap of freshly built table with serving table (blue/green deployment)
+	err = db.Table().Do(ctx,
+		func(ctx context.Context, s table.Session) (err error) {
+			return s.RenameTables(ctx,
+				options.RenameTablesItem(
+					path.Join(db.Name(), "series"),
+					path.Join(db.Name(), "series_old"),
+					true,
+				),
+				options.RenameTablesItem(
+					path.Join(db.Name(), "series_new"),
+					path.Join(db.Name(), "series"),
+					false,
+				),
+			)
+		},
+		table.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
+
+func Example_readRows() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	err = db.Table().Do(ctx,
+		func(ctx context.Context, s table.Session) (err error) {
+			// point lookup by primary keys without compiling YQL query
+			res, err := s.ReadRows(ctx, path.Join(db.Name(), "series"),
+				types.ListValue(
+					types.StructValue(types.StructFieldValue("series_id", types.Uint64Value(1))),
+					types.StructValue(types.StructFieldValue("series_id", types.Uint64Value(2))),
+				),
+				options.ReadColumns("series_id", "title"),
+			)
+			if err != nil {
+				return err
+			}
+			defer res.Close()
+			for res.NextResultSet(ctx) {
+				for res.NextRow() {
+					var (
+						id    uint64
+						title *string
+					)
+					if err = res.ScanNamed(
+						named.Required("series_id", &id),
+						named.Optional("title", &title),
+					); err != nil {
+						return err
+					}
+					fmt.Printf("id=%d, title=%v\n", id, title)
+				}
+			}
+
+			return res.Err()
+		},
+		table.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
+
+func Example_createTableWithColumnFamilies() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	err = db.Table().Do(ctx,
+		func(ctx context.Context, s table.Session) (err error) {
+			return s.CreateTable(ctx, path.Join(db.Name(), "episodes"),
+				options.WithColumn("series_id", types.Optional(types.TypeUint64)),
+				options.WithColumn("episode_id", types.Optional(types.TypeUint64)),
+				options.WithColumnMeta(options.NewTableColumn("title", types.Optional(types.TypeText), "hot")),
+				options.WithColumnMeta(options.NewTableColumn("script", types.Optional(types.TypeBytes), "cold")),
+				options.WithPrimaryKeyColumn("series_id", "episode_id"),
+				options.WithStorageSettings(options.StorageSettings{
+					TableCommitLog0: options.StoragePool{Media: "ssd"},
+					TableCommitLog1: options.StoragePool{Media: "ssd"},
+				}),
+				options.WithColumnFamilies(
+					options.ColumnFamily{
+						Name:         "hot",
+						Data:         options.StoragePool{Media: "ssd"},
+						Compression:  options.ColumnFamilyCompressionNone,
+						KeepInMemory: options.FeatureEnabled,
+					},
+					options.ColumnFamily{
+						Name:        "cold",
+						Data:        options.StoragePool{Media: "hdd"},
+						Compression: options.ColumnFamilyCompressionLZ4,
+					},
+				),
+			)
+		},
+		table.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
+
+func Example_executeSchemeQuery() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	// migration may be read from .sql file
+	migration := `
+		CREATE TABLE series (
+			series_id Uint64,
+			title Text,
+			release_date Date,
+			PRIMARY KEY (series_id)
+		);
+		ALTER TABLE series ADD COLUMN comment Text;
+	`
+	err = db.Table().Do(ctx,
+		func(ctx context.Context, s table.Session) (err error) {
+			return s.ExecuteSchemeQuery(ctx, migration)
+		},
+		table.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
+
+func Example_bulkUpsertCsv() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	csv := []byte(`App,Host,HTTPCode,Message
+App_1,192.168.0.1,200,GET / HTTP/1.1
+App_2,192.168.0.2,,GET / HTTP/1.1
+`)
+	err = db.Table().BulkUpsert(ctx, "/local/bulk_upsert_example",
+		table.BulkUpsertDataCsv(csv,
+			table.WithCsvHeader(),
+			table.WithCsvNullValue([]byte("")),
+		),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
+
+func Example_createColumnTable() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	err = db.Table().Do(ctx,
+		func(ctx context.Context, s table.Session) (err error) {
+			err = s.ExecuteSchemeQuery(ctx, `
+				CREATE TABLE logs (
+					ts Timestamp NOT NULL,
+					host Text NOT NULL,
+					message Text,
+					PRIMARY KEY (ts, host)
+				)
+				PARTITION BY HASH(host)
+				WITH (
+					STORE = COLUMN,
+					AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 10
+				);
+			`)
+			if err != nil {
+				return err
+			}
+			desc, err := s.DescribeTable(ctx, path.Join(db.Name(), "logs"))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("store type: %s\n", desc.StoreType)
+
+			return nil
+		},
+		table.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
+
+func Example_createTableWithSerialColumn() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	err = db.Table().Do(ctx,
+		func(ctx context.Context, s table.Session) (err error) {
+			// Serial columns are declared with YQL because table service API
+			// does not describe sequences of columns
+			return s.ExecuteSchemeQuery(ctx, `
+				CREATE TABLE users (
+					id Serial,
+					name Text,
+					PRIMARY KEY (id)
+				);
+			`)
+		},
+		table.WithIdempotent(),
+	)
+	if err != nil {
+		fmt.Printf("unexpected error: %v", err)
+	}
+}
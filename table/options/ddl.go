@@ -0,0 +1,211 @@
+package options
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// CreateTableQuery renders table description as YQL `CREATE TABLE` statement
+//
+// Columns, primary key, indexes, column families, store type, partitioning (including
+// `PARTITION BY HASH` columns of column tables), read replicas, key bloom filter and TTL settings
+// are rendered. Column families without settings are omitted. Statistics, key ranges and
+// changefeeds are not part of `CREATE TABLE` statement and are ignored.
+func (d Description) CreateTableQuery(tablePath string) string {
+	var (
+		buf  strings.Builder
+		defs = make([]string, 0, len(d.Columns)+len(d.Indexes)+len(d.ColumnFamilies)+1)
+	)
+	for _, c := range d.Columns {
+		defs = append(defs, columnDefinition(c))
+	}
+	for _, idx := range d.Indexes {
+		defs = append(defs, indexDefinition(idx))
+	}
+	if len(d.PrimaryKey) > 0 {
+		defs = append(defs, "PRIMARY KEY ("+quoteIdentifiers(d.PrimaryKey)+")")
+	}
+	for _, cf := range d.ColumnFamilies {
+		if def, ok := columnFamilyDefinition(cf); ok {
+			defs = append(defs, def)
+		}
+	}
+
+	buf.WriteString("CREATE TABLE ")
+	buf.WriteString(quoteIdentifier(tablePath))
+	buf.WriteString(" (\n\t")
+	buf.WriteString(strings.Join(defs, ",\n\t"))
+	buf.WriteString("\n)")
+
+	if partitionBy := d.PartitioningSettings.PartitionBy; len(partitionBy) > 0 {
+		buf.WriteString("\nPARTITION BY HASH(")
+		buf.WriteString(quoteIdentifiers(partitionBy))
+		buf.WriteString(")")
+	}
+
+	if settings := d.tableSettings(); len(settings) > 0 {
+		buf.WriteString("\nWITH (\n\t")
+		buf.WriteString(strings.Join(settings, ",\n\t"))
+		buf.WriteString("\n)")
+	}
+	buf.WriteString(";\n")
+
+	return buf.String()
+}
+
+func (d Description) tableSettings() (settings []string) {
+	if d.StoreType == StoreTypeColumn {
+		settings = append(settings, "STORE = COLUMN")
+	}
+	ps := d.PartitioningSettings
+	if s := featureFlagSetting(ps.PartitioningBySize); s != "" {
+		settings = append(settings, "AUTO_PARTITIONING_BY_SIZE = "+s)
+	}
+	if ps.PartitionSizeMb > 0 {
+		settings = append(settings,
+			"AUTO_PARTITIONING_PARTITION_SIZE_MB = "+strconv.FormatUint(ps.PartitionSizeMb, 10),
+		)
+	}
+	if s := featureFlagSetting(ps.PartitioningByLoad); s != "" {
+		settings = append(settings, "AUTO_PARTITIONING_BY_LOAD = "+s)
+	}
+	if ps.MinPartitionsCount > 0 {
+		settings = append(settings,
+			"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = "+strconv.FormatUint(ps.MinPartitionsCount, 10),
+		)
+	}
+	if ps.MaxPartitionsCount > 0 {
+		settings = append(settings,
+			"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = "+strconv.FormatUint(ps.MaxPartitionsCount, 10),
+		)
+	}
+	if s := featureFlagSetting(d.KeyBloomFilter); s != "" {
+		settings = append(settings, "KEY_BLOOM_FILTER = "+s)
+	}
+	if rr := d.ReadReplicaSettings; rr.Count > 0 {
+		switch rr.Type {
+		case ReadReplicasPerAzReadReplicas:
+			settings = append(settings, fmt.Sprintf("READ_REPLICAS_SETTINGS = \"PER_AZ:%d\"", rr.Count))
+		case ReadReplicasAnyAzReadReplicas:
+			settings = append(settings, fmt.Sprintf("READ_REPLICAS_SETTINGS = \"ANY_AZ:%d\"", rr.Count))
+		}
+	}
+	if ttl := d.TimeToLiveSettings; ttl != nil {
+		settings = append(settings, timeToLiveSetting(*ttl))
+	}
+	if d.Tiering != "" {
+		settings = append(settings, "TIERING = "+strconv.Quote(d.Tiering))
+	}
+
+	return settings
+}
+
+func columnDefinition(c Column) string {
+	var buf strings.Builder
+	buf.WriteString(quoteIdentifier(c.Name))
+	buf.WriteByte(' ')
+	optional, nullable := c.Type.(types.Optional)
+	if nullable {
+		buf.WriteString(optional.InnerType().Yql())
+	} else {
+		buf.WriteString(c.Type.Yql())
+	}
+	if c.Family != "" {
+		buf.WriteString(" FAMILY ")
+		buf.WriteString(quoteIdentifier(c.Family))
+	}
+	if !nullable {
+		buf.WriteString(" NOT NULL")
+	}
+
+	return buf.String()
+}
+
+func indexDefinition(idx IndexDescription) string {
+	var buf strings.Builder
+	buf.WriteString("INDEX ")
+	buf.WriteString(quoteIdentifier(idx.Name))
+	switch idx.Type {
+	case IndexTypeGlobalAsync:
+		buf.WriteString(" GLOBAL ASYNC ON (")
+	default:
+		buf.WriteString(" GLOBAL ON (")
+	}
+	buf.WriteString(quoteIdentifiers(idx.IndexColumns))
+	buf.WriteString(")")
+	if len(idx.DataColumns) > 0 {
+		buf.WriteString(" COVER (")
+		buf.WriteString(quoteIdentifiers(idx.DataColumns))
+		buf.WriteString(")")
+	}
+
+	return buf.String()
+}
+
+// columnFamilyDefinition renders column family definition or returns false
+// if column family has no settings (empty list of settings is not valid YQL)
+func columnFamilyDefinition(cf ColumnFamily) (string, bool) {
+	var settings []string
+	if cf.Data.Media != "" {
+		settings = append(settings, "DATA = "+strconv.Quote(cf.Data.Media))
+	}
+	switch cf.Compression {
+	case ColumnFamilyCompressionNone:
+		settings = append(settings, "COMPRESSION = \"off\"")
+	case ColumnFamilyCompressionLZ4:
+		settings = append(settings, "COMPRESSION = \"lz4\"")
+	}
+
+	if len(settings) == 0 {
+		return "", false
+	}
+
+	return "FAMILY " + quoteIdentifier(cf.Name) + " (" + strings.Join(settings, ", ") + ")", true
+}
+
+func timeToLiveSetting(ttl TimeToLiveSettings) string {
+	s := fmt.Sprintf("TTL = Interval(\"PT%dS\") ON %s", ttl.ExpireAfterSeconds, quoteIdentifier(ttl.ColumnName))
+	if ttl.Mode != TimeToLiveModeValueSinceUnixEpoch || ttl.ColumnUnit == nil {
+		return s
+	}
+	switch *ttl.ColumnUnit {
+	case TimeToLiveUnitSeconds:
+		return s + " AS SECONDS"
+	case TimeToLiveUnitMilliseconds:
+		return s + " AS MILLISECONDS"
+	case TimeToLiveUnitMicroseconds:
+		return s + " AS MICROSECONDS"
+	case TimeToLiveUnitNanoseconds:
+		return s + " AS NANOSECONDS"
+	default:
+		return s
+	}
+}
+
+func featureFlagSetting(f FeatureFlag) string {
+	switch f {
+	case FeatureEnabled:
+		return "ENABLED"
+	case FeatureDisabled:
+		return "DISABLED"
+	default:
+		return ""
+	}
+}
+
+func quoteIdentifier(name string) string {
+	return xstring.QuoteIdentifier(name)
+}
+
+func quoteIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteIdentifier(name)
+	}
+
+	return strings.Join(quoted, ", ")
+}
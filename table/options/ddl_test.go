@@ -0,0 +1,123 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestDescriptionCreateTableQuery(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		desc Description
+		exp  string
+	}{
+		{
+			name: "Minimal",
+			desc: Description{
+				Columns: []Column{
+					{Name: "id", Type: types.Uint64},
+					{Name: "title", Type: types.NewOptional(types.Text)},
+				},
+				PrimaryKey: []string{"id"},
+			},
+			exp: "CREATE TABLE `/local/series` (\n" +
+				"\t`id` Uint64 NOT NULL,\n" +
+				"\t`title` Utf8,\n" +
+				"\tPRIMARY KEY (`id`)\n" +
+				");\n",
+		},
+		{
+			name: "Column",
+			desc: Description{
+				Columns: []Column{
+					{Name: "id", Type: types.Uint64},
+				},
+				PrimaryKey: []string{"id"},
+				StoreType:  StoreTypeColumn,
+				PartitioningSettings: PartitioningSettings{
+					PartitionBy: []string{"id"},
+				},
+			},
+			exp: "CREATE TABLE `/local/series` (\n" +
+				"\t`id` Uint64 NOT NULL,\n" +
+				"\tPRIMARY KEY (`id`)\n" +
+				")\n" +
+				"PARTITION BY HASH(`id`)\n" +
+				"WITH (\n" +
+				"\tSTORE = COLUMN\n" +
+				");\n",
+		},
+		{
+			name: "Full",
+			desc: Description{
+				Columns: []Column{
+					{Name: "series_id", Type: types.NewOptional(types.Uint64)},
+					{Name: "title", Type: types.NewOptional(types.Text), Family: "hot"},
+					{Name: "expire_at", Type: types.NewOptional(types.Uint32)},
+				},
+				PrimaryKey: []string{"series_id"},
+				Indexes: []IndexDescription{
+					{
+						Name:         "idx_title",
+						IndexColumns: []string{"title"},
+						DataColumns:  []string{"expire_at"},
+						Type:         IndexTypeGlobalAsync,
+					},
+				},
+				ColumnFamilies: []ColumnFamily{
+					{
+						Name: "default",
+					},
+					{
+						Name:        "hot",
+						Data:        StoragePool{Media: "ssd"},
+						Compression: ColumnFamilyCompressionLZ4,
+					},
+				},
+				PartitioningSettings: PartitioningSettings{
+					PartitioningBySize: FeatureEnabled,
+					PartitionSizeMb:    512,
+					PartitioningByLoad: FeatureDisabled,
+					MinPartitionsCount: 4,
+					MaxPartitionsCount: 64,
+				},
+				KeyBloomFilter: FeatureEnabled,
+				ReadReplicaSettings: ReadReplicasSettings{
+					Type:  ReadReplicasAnyAzReadReplicas,
+					Count: 2,
+				},
+				TimeToLiveSettings: func() *TimeToLiveSettings {
+					ttl := NewTTLSettings().ColumnSeconds("expire_at").ExpireAfter(time.Hour)
+
+					return &ttl
+				}(),
+			},
+			exp: "CREATE TABLE `/local/series` (\n" +
+				"\t`series_id` Uint64,\n" +
+				"\t`title` Utf8 FAMILY `hot`,\n" +
+				"\t`expire_at` Uint32,\n" +
+				"\tINDEX `idx_title` GLOBAL ASYNC ON (`title`) COVER (`expire_at`),\n" +
+				"\tPRIMARY KEY (`series_id`),\n" +
+				"\tFAMILY `hot` (DATA = \"ssd\", COMPRESSION = \"lz4\")\n" +
+				")\n" +
+				"WITH (\n" +
+				"\tAUTO_PARTITIONING_BY_SIZE = ENABLED,\n" +
+				"\tAUTO_PARTITIONING_PARTITION_SIZE_MB = 512,\n" +
+				"\tAUTO_PARTITIONING_BY_LOAD = DISABLED,\n" +
+				"\tAUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 4,\n" +
+				"\tAUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 64,\n" +
+				"\tKEY_BLOOM_FILTER = ENABLED,\n" +
+				"\tREAD_REPLICAS_SETTINGS = \"ANY_AZ:2\",\n" +
+				"\tTTL = Interval(\"PT3600S\") ON `expire_at` AS SECONDS\n" +
+				");\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp, tt.desc.CreateTableQuery("/local/series"))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
@@ -59,6 +60,41 @@ type Description struct {
 	TimeToLiveSettings   *TimeToLiveSettings
 	Changefeeds          []ChangefeedDescription
 	Tiering              string
+	StoreType            StoreType
+}
+
+// StoreType is a kind of table storage
+type StoreType uint8
+
+const (
+	StoreTypeUnspecified StoreType = iota
+	// StoreTypeRow means row-oriented (OLTP) table
+	StoreTypeRow
+	// StoreTypeColumn means column-oriented (OLAP) table
+	StoreTypeColumn
+)
+
+func (t StoreType) String() string {
+	switch t {
+	case StoreTypeRow:
+		return "row"
+	case StoreTypeColumn:
+		return "column"
+	default:
+		return "unspecified"
+	}
+}
+
+// NewStoreType returns store type of table by type of scheme entry
+func NewStoreType(t Ydb_Scheme.Entry_Type) StoreType {
+	switch t {
+	case Ydb_Scheme.Entry_TABLE:
+		return StoreTypeRow
+	case Ydb_Scheme.Entry_COLUMN_TABLE:
+		return StoreTypeColumn
+	default:
+		return StoreTypeUnspecified
+	}
 }
 
 type TableStats struct {
@@ -247,6 +283,7 @@ type PartitioningSettings struct {
 	PartitioningBySize FeatureFlag
 	PartitionSizeMb    uint64
 	PartitioningByLoad FeatureFlag
+	PartitionBy        []string
 	MinPartitionsCount uint64
 	MaxPartitionsCount uint64
 }
@@ -256,6 +293,7 @@ func (ps PartitioningSettings) toYDB() *Ydb_Table.PartitioningSettings {
 		PartitioningBySize: ps.PartitioningBySize.ToYDB(),
 		PartitionSizeMb:    ps.PartitionSizeMb,
 		PartitioningByLoad: ps.PartitioningByLoad.ToYDB(),
+		PartitionBy:        ps.PartitionBy,
 		MinPartitionsCount: ps.MinPartitionsCount,
 		MaxPartitionsCount: ps.MaxPartitionsCount,
 	}
@@ -266,6 +304,7 @@ func NewPartitioningSettings(ps *Ydb_Table.PartitioningSettings) PartitioningSet
 		PartitioningBySize: feature.FromYDB(ps.GetPartitioningBySize()),
 		PartitionSizeMb:    ps.GetPartitionSizeMb(),
 		PartitioningByLoad: feature.FromYDB(ps.GetPartitioningByLoad()),
+		PartitionBy:        ps.GetPartitionBy(),
 		MinPartitionsCount: ps.GetMinPartitionsCount(),
 		MaxPartitionsCount: ps.GetMaxPartitionsCount(),
 	}
@@ -561,18 +600,22 @@ func (unit *TimeToLiveUnit) ToYDB() Ydb_Table.ValueSinceUnixEpochModeSettings_Un
 }
 
 type ChangefeedDescription struct {
-	Name   string
-	Mode   ChangefeedMode
-	Format ChangefeedFormat
-	State  ChangefeedState
+	Name              string
+	Mode              ChangefeedMode
+	Format            ChangefeedFormat
+	State             ChangefeedState
+	VirtualTimestamps bool
+	Attributes        map[string]string
 }
 
 func NewChangefeedDescription(proto *Ydb_Table.ChangefeedDescription) ChangefeedDescription {
 	return ChangefeedDescription{
-		Name:   proto.GetName(),
-		Mode:   ChangefeedMode(proto.GetMode()),
-		Format: ChangefeedFormat(proto.GetFormat()),
-		State:  ChangefeedState(proto.GetState()),
+		Name:              proto.GetName(),
+		Mode:              ChangefeedMode(proto.GetMode()),
+		Format:            ChangefeedFormat(proto.GetFormat()),
+		State:             ChangefeedState(proto.GetState()),
+		VirtualTimestamps: proto.GetVirtualTimestamps(),
+		Attributes:        proto.GetAttributes(),
 	}
 }
 
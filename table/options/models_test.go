@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 )
 
 func TestTimeToLiveSettingsFluentModifiers(t *testing.T) {
@@ -72,3 +73,9 @@ func TestTimeToLiveSettingsFluentModifiers(t *testing.T) {
 		})
 	}
 }
+
+func TestNewStoreType(t *testing.T) {
+	require.Equal(t, StoreTypeRow, NewStoreType(Ydb_Scheme.Entry_TABLE))
+	require.Equal(t, StoreTypeColumn, NewStoreType(Ydb_Scheme.Entry_COLUMN_TABLE))
+	require.Equal(t, StoreTypeUnspecified, NewStoreType(Ydb_Scheme.Entry_DIRECTORY))
+}
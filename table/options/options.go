@@ -1,9 +1,12 @@
 package options
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
@@ -204,6 +207,33 @@ func WithDropIndex(name string) AlterTableOption {
 	return dropIndex(name)
 }
 
+type renameIndex struct {
+	src                string
+	dst                string
+	replaceDestination bool
+}
+
+func (i renameIndex) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.RenameIndexes = append(d.RenameIndexes, &Ydb_Table.RenameIndexItem{
+		SourceName:         i.src,
+		DestinationName:    i.dst,
+		ReplaceDestination: i.replaceDestination,
+	})
+}
+
+// WithRenameIndex renames index src to dst in AlterTable request
+//
+// If replaceDestination is true, existing index dst is atomically replaced by src.
+// This allows to rebuild index online: create new index with temporary name and
+// rename it over the old one.
+func WithRenameIndex(src, dst string, replaceDestination bool) AlterTableOption {
+	return renameIndex{
+		src:                src,
+		dst:                dst,
+		replaceDestination: replaceDestination,
+	}
+}
+
 type indexColumns []string
 
 func (columns indexColumns) ApplyIndexOption(d *indexDesc) {
@@ -231,16 +261,14 @@ func WithIndexType(t IndexType) IndexOption {
 type columnFamilies []ColumnFamily
 
 func (cf columnFamilies) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
-	d.AddColumnFamilies = make([]*Ydb_Table.ColumnFamily, len(cf))
 	for i := range cf {
-		d.AddColumnFamilies[i] = cf[i].toYDB()
+		d.AddColumnFamilies = append(d.AddColumnFamilies, cf[i].toYDB())
 	}
 }
 
 func (cf columnFamilies) ApplyCreateTableOption(d *CreateTableDesc, a *allocator.Allocator) {
-	d.ColumnFamilies = make([]*Ydb_Table.ColumnFamily, len(cf))
 	for i := range cf {
-		d.ColumnFamilies[i] = cf[i].toYDB()
+		d.ColumnFamilies = append(d.ColumnFamilies, cf[i].toYDB())
 	}
 }
 
@@ -754,8 +782,37 @@ func WithAddColumnFamilies(cf ...ColumnFamily) AlterTableOption {
 	return columnFamilies(cf)
 }
 
+type alterColumnFamilies []ColumnFamily
+
+func (cf alterColumnFamilies) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	for i := range cf {
+		d.AlterColumnFamilies = append(d.AlterColumnFamilies, cf[i].toYDB())
+	}
+}
+
+// WithAlterColumnFamilies changes settings of existing column families in AlterTable request
 func WithAlterColumnFamilies(cf ...ColumnFamily) AlterTableOption {
-	return columnFamilies(cf)
+	return alterColumnFamilies(cf)
+}
+
+type alterColumnFamily struct {
+	column string
+	family string
+}
+
+func (c alterColumnFamily) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.AlterColumns = append(d.AlterColumns, &Ydb_Table.ColumnMeta{
+		Name:   c.column,
+		Family: c.family,
+	})
+}
+
+// WithAlterColumnFamily moves existing column to given column family in AlterTable request
+func WithAlterColumnFamily(column, family string) AlterTableOption {
+	return alterColumnFamily{
+		column: column,
+		family: family,
+	}
 }
 
 func WithAlterReadReplicasSettings(rr ReadReplicasSettings) AlterTableOption {
@@ -790,6 +847,108 @@ func WithDropTimeToLive() AlterTableOption {
 	return dropTimeToLive{}
 }
 
+type (
+	changefeedDesc   Ydb_Table.Changefeed
+	ChangefeedOption interface {
+		ApplyChangefeedOption(d *changefeedDesc)
+	}
+)
+
+type changefeed struct {
+	name   string
+	mode   ChangefeedMode
+	format ChangefeedFormat
+	opts   []ChangefeedOption
+}
+
+func (cf changefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	x := &Ydb_Table.Changefeed{
+		Name:   cf.name,
+		Mode:   Ydb_Table.ChangefeedMode_Mode(cf.mode),
+		Format: Ydb_Table.ChangefeedFormat_Format(cf.format),
+	}
+	for _, opt := range cf.opts {
+		if opt != nil {
+			opt.ApplyChangefeedOption((*changefeedDesc)(x))
+		}
+	}
+	d.AddChangefeeds = append(d.AddChangefeeds, x)
+}
+
+// WithAddChangefeed adds changefeed (CDC stream) to table in AlterTable request
+func WithAddChangefeed(name string, mode ChangefeedMode, format ChangefeedFormat, opts ...ChangefeedOption) AlterTableOption {
+	return changefeed{
+		name:   name,
+		mode:   mode,
+		format: format,
+		opts:   opts,
+	}
+}
+
+type dropChangefeed string
+
+func (name dropChangefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.DropChangefeeds = append(d.DropChangefeeds, string(name))
+}
+
+// WithDropChangefeed drops changefeed from table in AlterTable request
+func WithDropChangefeed(name string) AlterTableOption {
+	return dropChangefeed(name)
+}
+
+type changefeedRetentionPeriod time.Duration
+
+func (period changefeedRetentionPeriod) ApplyChangefeedOption(d *changefeedDesc) {
+	d.RetentionPeriod = durationpb.New(time.Duration(period))
+}
+
+// WithChangefeedRetentionPeriod defines how long data in changefeed's underlying topic should be stored
+func WithChangefeedRetentionPeriod(period time.Duration) ChangefeedOption {
+	return changefeedRetentionPeriod(period)
+}
+
+type changefeedVirtualTimestamps bool
+
+func (flag changefeedVirtualTimestamps) ApplyChangefeedOption(d *changefeedDesc) {
+	d.VirtualTimestamps = bool(flag)
+}
+
+// WithChangefeedVirtualTimestamps enables emitting of virtual timestamps of changes along with data
+func WithChangefeedVirtualTimestamps() ChangefeedOption {
+	return changefeedVirtualTimestamps(true)
+}
+
+type changefeedInitialScan bool
+
+func (flag changefeedInitialScan) ApplyChangefeedOption(d *changefeedDesc) {
+	d.InitialScan = bool(flag)
+}
+
+// WithChangefeedInitialScan enables output of the current state of the table before changes
+func WithChangefeedInitialScan() ChangefeedOption {
+	return changefeedInitialScan(true)
+}
+
+type changefeedAttribute struct {
+	key   string
+	value string
+}
+
+func (a changefeedAttribute) ApplyChangefeedOption(d *changefeedDesc) {
+	if d.Attributes == nil {
+		d.Attributes = make(map[string]string)
+	}
+	d.Attributes[a.key] = a.value
+}
+
+// WithChangefeedAttribute sets attribute of changefeed
+func WithChangefeedAttribute(key, value string) ChangefeedOption {
+	return changefeedAttribute{
+		key:   key,
+		value: value,
+	}
+}
+
 type (
 	CopyTableDesc   Ydb_Table.CopyTableRequest
 	CopyTableOption func(*CopyTableDesc)
@@ -835,6 +994,7 @@ type (
 		*Ydb_Table.ExecuteDataQueryRequest
 
 		IgnoreTruncated bool
+		StrictNullScan  bool
 	}
 	ExecuteDataQueryOption interface {
 		ApplyExecuteDataQueryOption(d *ExecuteDataQueryDesc, a *allocator.Allocator) []grpc.CallOption
@@ -908,6 +1068,16 @@ func WithIgnoreTruncated() ExecuteDataQueryOption {
 	})
 }
 
+// WithStrictNullScan makes Scan of result return result.ErrNullValue on NULL values of columns
+// which are scanned into destinations without NULL representation (instead of zero values)
+func WithStrictNullScan() ExecuteDataQueryOption {
+	return executeDataQueryOptionFunc(func(desc *ExecuteDataQueryDesc, a *allocator.Allocator) []grpc.CallOption {
+		desc.StrictNullScan = true
+
+		return nil
+	})
+}
+
 // WithQueryCachePolicyKeepInCache manages keep-in-cache policy
 //
 // Deprecated: data queries always executes with enabled keep-in-cache policy.
@@ -1179,6 +1349,80 @@ func ReadRowLimit(n uint64) ReadTableOption {
 	return readRowLimitOption(n)
 }
 
+type (
+	// ReadTableResumeDesc describes resuming of StreamReadTable after retryable stream errors
+	ReadTableResumeDesc struct {
+		KeyColumns []string
+		Checkpoint func(lastKey value.Value)
+	}
+	ReadTableResumeOption interface {
+		ApplyReadTableResumeOption(desc *ReadTableResumeDesc)
+	}
+	readResumableOption ReadTableResumeDesc
+)
+
+func (opt readResumableOption) ApplyReadTableOption(desc *ReadTableDesc, a *allocator.Allocator) {
+	desc.Ordered = true
+}
+
+func (opt readResumableOption) ApplyReadTableResumeOption(desc *ReadTableResumeDesc) {
+	*desc = ReadTableResumeDesc(opt)
+}
+
+// ReadResumable returns ReadTableOption which makes StreamReadTable resume reading
+// after the last received row on retryable stream errors instead of returning error.
+// Stream is resumed a limited number of times in a row, and ReadRowLimit accounts
+// rows received before resuming.
+//
+// keyColumns must be the primary key columns of table. ReadResumable enables ordered
+// read. If read columns are specified, they must contain all of keyColumns.
+//
+// If checkpoint is not nil, it is called with the key (tuple of keyColumns values) of
+// the last received row after each received part of result. This key may be used with
+// ReadGreater for manual resuming of reading later.
+func ReadResumable(keyColumns []string, checkpoint func(lastKey value.Value)) ReadTableOption {
+	return readResumableOption{
+		KeyColumns: keyColumns,
+		Checkpoint: checkpoint,
+	}
+}
+
+type (
+	// ReadTableSnapshot is a point-in-time view of table which StreamReadTable reads from
+	ReadTableSnapshot struct {
+		PlanStep uint64
+		TxID     uint64
+	}
+	// ReadTableSnapshotDesc describes handling of snapshot metadata of StreamReadTable
+	ReadTableSnapshotDesc struct {
+		OnSnapshot func(snapshot ReadTableSnapshot)
+	}
+	ReadTableSnapshotOption interface {
+		ApplyReadTableSnapshotOption(desc *ReadTableSnapshotDesc)
+	}
+	readSnapshotInfoOption ReadTableSnapshotDesc
+)
+
+func (opt readSnapshotInfoOption) ApplyReadTableOption(desc *ReadTableDesc, a *allocator.Allocator) {
+	desc.UseSnapshot = FeatureEnabled.ToYDB()
+}
+
+func (opt readSnapshotInfoOption) ApplyReadTableSnapshotOption(desc *ReadTableSnapshotDesc) {
+	*desc = ReadTableSnapshotDesc(opt)
+}
+
+// ReadSnapshot returns ReadTableOption which makes StreamReadTable read from
+// consistent snapshot of table and reports snapshot metadata.
+//
+// If onSnapshot is not nil, it is called with snapshot of table when it is received
+// from server. If StreamReadTable re-opens stream (see ReadResumable), onSnapshot
+// is called again with snapshot of new stream.
+func ReadSnapshot(onSnapshot func(snapshot ReadTableSnapshot)) ReadTableOption {
+	return readSnapshotInfoOption{
+		OnSnapshot: onSnapshot,
+	}
+}
+
 func (d *ReadTableDesc) initKeyRange() {
 	if d.KeyRange == nil {
 		d.KeyRange = new(Ydb_Table.KeyRange)
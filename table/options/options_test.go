@@ -2,6 +2,7 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -196,10 +197,11 @@ func TestAlterTableOptions(t *testing.T) {
 			Compression:  ColumnFamilyCompressionLZ4,
 			KeepInMemory: FeatureEnabled,
 		}
-		opt := WithAlterColumnFamilies(cf)
+		opt := WithAddColumnFamilies(cf)
 		req := Ydb_Table.AlterTableRequest{}
 		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
 		if len(req.GetAddColumnFamilies()) != 1 ||
+			len(req.GetAlterColumnFamilies()) != 0 ||
 			req.GetAddColumnFamilies()[0].GetName() != cf.Name ||
 			req.GetAddColumnFamilies()[0].GetData().GetMedia() != cf.Data.Media ||
 			req.GetAddColumnFamilies()[0].GetCompression() != cf.Compression.toYDB() ||
@@ -215,11 +217,30 @@ func TestAlterTableOptions(t *testing.T) {
 		opt := WithAlterColumnFamilies(cf)
 		req := Ydb_Table.AlterTableRequest{}
 		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
-		if len(req.GetAddColumnFamilies()) != 1 ||
-			req.GetAddColumnFamilies()[0].GetName() != cf.Name ||
-			req.GetAddColumnFamilies()[0].GetData() != nil ||
-			req.GetAddColumnFamilies()[0].GetCompression() != cf.Compression.toYDB() ||
-			req.GetAddColumnFamilies()[0].GetKeepInMemory() != Ydb.FeatureFlag_STATUS_UNSPECIFIED {
+		if len(req.GetAlterColumnFamilies()) != 1 ||
+			len(req.GetAddColumnFamilies()) != 0 ||
+			req.GetAlterColumnFamilies()[0].GetName() != cf.Name ||
+			req.GetAlterColumnFamilies()[0].GetData() != nil ||
+			req.GetAlterColumnFamilies()[0].GetCompression() != cf.Compression.toYDB() ||
+			req.GetAlterColumnFamilies()[0].GetKeepInMemory() != Ydb.FeatureFlag_STATUS_UNSPECIFIED {
+			t.Errorf("Alter table options is not as expected")
+		}
+	}
+	{
+		req := Ydb_Table.AlterTableRequest{}
+		for _, opt := range []AlterTableOption{
+			WithAddColumnFamilies(ColumnFamily{Name: "a"}),
+			WithAddColumnFamilies(ColumnFamily{Name: "b"}),
+			WithAlterColumnFamily("c", "b"),
+		} {
+			opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		}
+		if len(req.GetAddColumnFamilies()) != 2 ||
+			req.GetAddColumnFamilies()[0].GetName() != "a" ||
+			req.GetAddColumnFamilies()[1].GetName() != "b" ||
+			len(req.GetAlterColumns()) != 1 ||
+			req.GetAlterColumns()[0].GetName() != "c" ||
+			req.GetAlterColumns()[0].GetFamily() != "b" {
 			t.Errorf("Alter table options is not as expected")
 		}
 	}
@@ -252,3 +273,133 @@ func TestAlterTableOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestChangefeedOptions(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	req := Ydb_Table.AlterTableRequest{}
+	for _, opt := range []AlterTableOption{
+		WithAddChangefeed("feed", ChangefeedModeUpdates, ChangefeedFormatJSON,
+			WithChangefeedRetentionPeriod(time.Hour),
+			WithChangefeedVirtualTimestamps(),
+			WithChangefeedInitialScan(),
+			WithChangefeedAttribute("k", "v"),
+		),
+		WithDropChangefeed("old_feed"),
+	} {
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+	}
+	require.Len(t, req.GetAddChangefeeds(), 1)
+	cf := req.GetAddChangefeeds()[0]
+	require.Equal(t, "feed", cf.GetName())
+	require.Equal(t, Ydb_Table.ChangefeedMode_MODE_UPDATES, cf.GetMode())
+	require.Equal(t, Ydb_Table.ChangefeedFormat_FORMAT_JSON, cf.GetFormat())
+	require.Equal(t, time.Hour, cf.GetRetentionPeriod().AsDuration())
+	require.True(t, cf.GetVirtualTimestamps())
+	require.True(t, cf.GetInitialScan())
+	require.Equal(t, map[string]string{"k": "v"}, cf.GetAttributes())
+	require.Equal(t, []string{"old_feed"}, req.GetDropChangefeeds())
+}
+
+func TestPartitioningSettings(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	{
+		req := Ydb_Table.CreateTableRequest{}
+		WithPartitioningSettings(
+			WithPartitioningBySize(FeatureEnabled),
+			WithPartitionSizeMb(512),
+			WithPartitioningByLoad(FeatureEnabled),
+			WithPartitioningBy([]string{"a", "b"}),
+			WithMinPartitionsCount(4),
+			WithMaxPartitionsCount(64),
+		).ApplyCreateTableOption((*CreateTableDesc)(&req), a)
+		require.Equal(t, PartitioningSettings{
+			PartitioningBySize: FeatureEnabled,
+			PartitionSizeMb:    512,
+			PartitioningByLoad: FeatureEnabled,
+			PartitionBy:        []string{"a", "b"},
+			MinPartitionsCount: 4,
+			MaxPartitionsCount: 64,
+		}, NewPartitioningSettings(req.GetPartitioningSettings()))
+	}
+	{
+		ps := PartitioningSettings{
+			PartitioningBySize: FeatureDisabled,
+			PartitioningByLoad: FeatureEnabled,
+			PartitionBy:        []string{"a"},
+			MinPartitionsCount: 1,
+			MaxPartitionsCount: 8,
+		}
+		req := Ydb_Table.AlterTableRequest{}
+		WithAlterPartitionSettingsObject(ps).ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Equal(t, ps, NewPartitioningSettings(req.GetAlterPartitioningSettings()))
+	}
+}
+
+func TestReadReplicasSettings(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	for _, rr := range []ReadReplicasSettings{
+		{Type: ReadReplicasPerAzReadReplicas, Count: 1},
+		{Type: ReadReplicasAnyAzReadReplicas, Count: 3},
+	} {
+		req := Ydb_Table.CreateTableRequest{}
+		WithReadReplicasSettings(rr).ApplyCreateTableOption((*CreateTableDesc)(&req), a)
+		require.Equal(t, rr, NewReadReplicasSettings(req.GetReadReplicasSettings()))
+	}
+}
+
+func TestAttributeOptions(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	{
+		req := Ydb_Table.CreateTableRequest{}
+		for _, opt := range []CreateTableOption{
+			WithAttribute("schema_version", "1"),
+			WithAttribute("owner", "team"),
+		} {
+			opt.ApplyCreateTableOption((*CreateTableDesc)(&req), a)
+		}
+		require.Equal(t, map[string]string{
+			"schema_version": "1",
+			"owner":          "team",
+		}, req.GetAttributes())
+	}
+	{
+		req := Ydb_Table.AlterTableRequest{}
+		for _, opt := range []AlterTableOption{
+			WithAddAttribute("a", "1"),
+			WithAlterAttribute("schema_version", "2"),
+			WithDropAttribute("owner"),
+		} {
+			opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		}
+		require.Equal(t, map[string]string{
+			"a":              "1",
+			"schema_version": "2",
+			"owner":          "",
+		}, req.GetAlterAttributes())
+	}
+}
+
+func TestIndexAlterOptions(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	req := Ydb_Table.AlterTableRequest{}
+	for _, opt := range []AlterTableOption{
+		WithDropIndex("idx_old"),
+		WithRenameIndex("idx_a", "idx_b", false),
+		WithRenameIndex("idx_title_new", "idx_title", true),
+	} {
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+	}
+	require.Equal(t, []string{"idx_old"}, req.GetDropIndexes())
+	require.Len(t, req.GetRenameIndexes(), 2)
+	require.Equal(t, "idx_a", req.GetRenameIndexes()[0].GetSourceName())
+	require.Equal(t, "idx_b", req.GetRenameIndexes()[0].GetDestinationName())
+	require.False(t, req.GetRenameIndexes()[0].GetReplaceDestination())
+	require.Equal(t, "idx_title_new", req.GetRenameIndexes()[1].GetSourceName())
+	require.Equal(t, "idx_title", req.GetRenameIndexes()[1].GetDestinationName())
+	require.True(t, req.GetRenameIndexes()[1].GetReplaceDestination())
+}
@@ -0,0 +1,40 @@
+package table
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// SetSessionPoolSizeLimit changes upper bound of session pool size of table client at runtime.
+//
+// If pool has more sessions than new limit, excess idle sessions are closed immediately
+// and sessions in use are closed on return into pool. Growth of pool happens on demand.
+func SetSessionPoolSizeLimit(client Client, sizeLimit int) error {
+	if c, has := client.(interface {
+		SetSizeLimit(sizeLimit int)
+	}); has {
+		c.SetSizeLimit(sizeLimit)
+
+		return nil
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf("client %T not supported session pool resizing", client))
+}
+
+// SetSessionPoolIdleThreshold changes idle threshold of session pool of table client at runtime.
+//
+// New threshold is applied on next check of idle sessions. It has no effect if closing of
+// idle sessions was disabled on table client creation.
+func SetSessionPoolIdleThreshold(client Client, idleThreshold time.Duration) error {
+	if c, has := client.(interface {
+		SetIdleThreshold(idleThreshold time.Duration)
+	}); has {
+		c.SetIdleThreshold(idleThreshold)
+
+		return nil
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf("client %T not supported changing of idle threshold", client))
+}
@@ -0,0 +1,19 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Stats returns snapshot of session pool state of table client
+func Stats(client Client) (*stats.Stats, error) {
+	if c, has := client.(interface {
+		Stats() *stats.Stats
+	}); has {
+		return c.Stats(), nil
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("client %T not supported stats", client))
+}
@@ -0,0 +1,68 @@
+package table
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+// ReadTableConcurrently reads table by shard key ranges in parallel.
+//
+// ReadTableConcurrently describes table with shard key bounds and makes StreamReadTable
+// for each key range, using no more than workers concurrent readers. If workers is less
+// than one, all key ranges are read concurrently.
+//
+// Each key range is read in separate retry loop with Client.Do, so f may be called
+// concurrently and may be called again for the same key range after retryable error.
+// f must return res.Err() after reading for stream errors to be retried.
+// Reading stops on first non-retryable error or context cancellation.
+func ReadTableConcurrently(
+	ctx context.Context,
+	c Client,
+	path string,
+	workers int,
+	f func(ctx context.Context, keyRange options.KeyRange, res result.StreamResult) error,
+	opts ...options.ReadTableOption,
+) error {
+	var keyRanges []options.KeyRange
+	err := c.Do(ctx, func(ctx context.Context, s Session) error {
+		desc, err := s.DescribeTable(ctx, path, options.WithShardKeyBounds())
+		if err != nil {
+			return err
+		}
+		keyRanges = desc.KeyRanges
+
+		return nil
+	}, WithIdempotent())
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	if workers > 0 {
+		g.SetLimit(workers)
+	}
+	for i := range keyRanges {
+		keyRange := keyRanges[i]
+		g.Go(func() error {
+			return c.Do(ctx, func(ctx context.Context, s Session) (err error) {
+				res, err := s.StreamReadTable(ctx, path,
+					append(opts[:len(opts):len(opts)], options.ReadKeyRange(keyRange))...,
+				)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = res.Close()
+				}()
+
+				return f(ctx, keyRange, res)
+			}, WithIdempotent())
+		})
+	}
+
+	return g.Wait()
+}
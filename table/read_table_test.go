@@ -0,0 +1,94 @@
+package table_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type readTableClient struct {
+	table.Client
+
+	session table.Session
+}
+
+func (c readTableClient) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	return op(ctx, c.session)
+}
+
+type readTableSession struct {
+	table.Session
+
+	keyRanges []options.KeyRange
+	err       error
+}
+
+func (s readTableSession) DescribeTable(
+	ctx context.Context, path string, opts ...options.DescribeTableOption,
+) (options.Description, error) {
+	return options.Description{KeyRanges: s.keyRanges}, nil
+}
+
+func (s readTableSession) StreamReadTable(
+	ctx context.Context, path string, opts ...options.ReadTableOption,
+) (result.StreamResult, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return readTableResult{}, nil
+}
+
+type readTableResult struct {
+	result.StreamResult
+}
+
+func (readTableResult) Close() error {
+	return nil
+}
+
+func TestReadTableConcurrently(t *testing.T) {
+	keyRanges := []options.KeyRange{
+		{To: types.TupleValue(types.Uint64Value(10))},
+		{From: types.TupleValue(types.Uint64Value(10)), To: types.TupleValue(types.Uint64Value(20))},
+		{From: types.TupleValue(types.Uint64Value(20))},
+	}
+	t.Run("OK", func(t *testing.T) {
+		var (
+			mu   sync.Mutex
+			read []options.KeyRange
+		)
+		err := table.ReadTableConcurrently(context.Background(),
+			readTableClient{session: readTableSession{keyRanges: keyRanges}},
+			"/local/test", 2,
+			func(ctx context.Context, keyRange options.KeyRange, res result.StreamResult) error {
+				mu.Lock()
+				defer mu.Unlock()
+				read = append(read, keyRange)
+
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.ElementsMatch(t, keyRanges, read)
+	})
+	t.Run("Error", func(t *testing.T) {
+		testErr := errors.New("test")
+		err := table.ReadTableConcurrently(context.Background(),
+			readTableClient{session: readTableSession{keyRanges: keyRanges, err: testErr}},
+			"/local/test", 0,
+			func(ctx context.Context, keyRange options.KeyRange, res result.StreamResult) error {
+				return nil
+			},
+		)
+		require.ErrorIs(t, err, testErr)
+	})
+}
@@ -0,0 +1,19 @@
+package result
+
+// ColumnMatching is a mode of matching of columns passed to NextResultSet(ctx, columns...)
+// with columns of result set. Mode is applied only if columns are passed.
+type ColumnMatching int
+
+const (
+	// ColumnMatchingLenient matches columns by names. Missing columns cause ErrColumnMismatch,
+	// extra columns of result set are skipped. It is default mode.
+	ColumnMatchingLenient ColumnMatching = iota
+
+	// ColumnMatchingStrict matches columns by names. Both missing and extra columns of result set
+	// cause ErrColumnMismatch.
+	ColumnMatchingStrict
+
+	// ColumnMatchingPositional matches columns by positions, names of passed columns are used only
+	// in errors. Passed column is missing if result set has fewer columns, extra columns are skipped.
+	ColumnMatchingPositional
+)
@@ -2,6 +2,33 @@ package result
 
 import (
 	"errors"
+	"fmt"
 )
 
 var ErrTruncated = errors.New("truncated result")
+
+// ErrNullValue is returned on scanning of NULL value into destination which cannot hold NULL
+// if strict NULL scan is enabled (with ydb.WithStrictNullScan() or options.WithStrictNullScan())
+type ErrNullValue struct {
+	Column string
+}
+
+func (err ErrNullValue) Error() string {
+	return fmt.Sprintf("NULL value of column %q cannot be scanned into non-nullable destination", err.Column)
+}
+
+// ErrColumnMismatch is returned if columns passed to NextResultSet don't match columns of result set
+// according to column matching mode (see ColumnMatching)
+type ErrColumnMismatch struct {
+	Column string
+	// Missing is true if column is missing in result set and false if column of result set is unexpected
+	Missing bool
+}
+
+func (err ErrColumnMismatch) Error() string {
+	if err.Missing {
+		return fmt.Sprintf("column %q is missing in result set", err.Column)
+	}
+
+	return fmt.Sprintf("unexpected column %q in result set", err.Column)
+}
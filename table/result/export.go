@@ -0,0 +1,167 @@
+package result
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+)
+
+type (
+	exportOptions struct {
+		comma      rune
+		noHeader   bool
+		nullString string
+	}
+	// ExportOption is an option for WriteCSV and WriteJSON
+	ExportOption func(o *exportOptions)
+)
+
+// WithCSVComma sets field delimiter of CSV (',' by default)
+func WithCSVComma(comma rune) ExportOption {
+	return func(o *exportOptions) {
+		o.comma = comma
+	}
+}
+
+// WithoutCSVHeader disables header lines with column names in CSV
+func WithoutCSVHeader() ExportOption {
+	return func(o *exportOptions) {
+		o.noHeader = true
+	}
+}
+
+// WithCSVNullString sets text of NULL values in CSV (empty string by default)
+func WithCSVNullString(s string) ExportOption {
+	return func(o *exportOptions) {
+		o.nullString = s
+	}
+}
+
+// WriteCSV writes rows of all remaining result sets of res into w in CSV format
+//
+// Header line with column names is written before first row of result set if columns
+// differ from columns of previous result set, so parts of streaming result have single header.
+// Values are written in text representation: strings as is, date and time values in RFC 3339 format,
+// containers in JSON.
+func WriteCSV(ctx context.Context, w io.Writer, res BaseResult, opts ...ExportOption) error {
+	o := exportOptions{comma: ','}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = o.comma
+	var header []string
+	err := rangeRows(ctx, res, func(columns []string, values []value.Value) error {
+		if !o.noHeader && !equalColumns(header, columns) {
+			header = columns
+			if err := cw.Write(header); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+		record := make([]string, len(values))
+		for i, v := range values {
+			if native := value.Native(v); native == nil {
+				record[i] = o.nullString
+			} else {
+				record[i] = value.NativeString(v)
+			}
+		}
+
+		return cw.Write(record)
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	cw.Flush()
+
+	return xerrors.WithStackTrace(cw.Error())
+}
+
+// WriteJSON writes rows of all remaining result sets of res into w in JSON Lines format
+// (one JSON object with column names as keys in order of columns per line)
+//
+// NULL values are written as null, Json values are embedded as is, String and Yson values are
+// written in base64, date and time values in RFC 3339 format, Interval values as nanoseconds,
+// NaN and infinite floating point values as "NaN", "Infinity" and "-Infinity" strings and containers
+// as arrays and objects.
+// CSV specific options are ignored.
+func WriteJSON(ctx context.Context, w io.Writer, res BaseResult, opts ...ExportOption) error {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+
+	err := rangeRows(ctx, res, func(columns []string, values []value.Value) error {
+		buffer.Reset()
+		buffer.WriteByte('{')
+		for i := range columns {
+			if i != 0 {
+				buffer.WriteByte(',')
+			}
+			name, err := json.Marshal(columns[i])
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			v, err := json.Marshal(value.Native(values[i]))
+			if err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("marshal column '%s' failed: %w", columns[i], err))
+			}
+			buffer.Write(name)
+			buffer.WriteByte(':')
+			buffer.Write(v)
+		}
+		buffer.WriteString("}\n")
+		_, err := w.Write(buffer.Bytes())
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func rangeRows(ctx context.Context, res BaseResult, f func(columns []string, values []value.Value) error) error {
+	for res.NextResultSet(ctx) {
+		columns := make([]string, 0, len(res.Columns()))
+		for _, c := range res.Columns() {
+			columns = append(columns, c.Name)
+		}
+		values := make([]value.Value, len(columns))
+		dst := make([]indexed.RequiredOrOptional, len(columns))
+		for i := range values {
+			dst[i] = &values[i]
+		}
+		for res.NextRow() {
+			if err := res.Scan(dst...); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if err := f(columns, values); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return res.Err()
+}
+
+func equalColumns(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,130 @@
+package result_test
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+func newResultSet(a *allocator.Allocator, names []string, rows ...[]value.Value) *Ydb.ResultSet {
+	set := &Ydb.ResultSet{}
+	for i, name := range names {
+		set.Columns = append(set.Columns, &Ydb.Column{
+			Name: name,
+			Type: value.ToYDB(rows[0][i], a).GetType(),
+		})
+	}
+	for _, row := range rows {
+		items := make([]*Ydb.Value, len(row))
+		for i, v := range row {
+			items[i] = value.ToYDB(v, a).GetValue()
+		}
+		set.Rows = append(set.Rows, &Ydb.Value{Items: items})
+	}
+
+	return set
+}
+
+func newResult(a *allocator.Allocator) result.Result {
+	names := []string{"id", "title", "created", "tags"}
+	created := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	return scanner.NewUnary([]*Ydb.ResultSet{
+		newResultSet(a, names,
+			[]value.Value{
+				value.Uint64Value(1),
+				value.OptionalValue(value.TextValue("a,b")),
+				value.TimestampValueFromTime(created),
+				value.ListValue(value.TextValue("x")),
+			},
+			[]value.Value{
+				value.Uint64Value(2),
+				value.NullValue(value.TextValue("").Type()),
+				value.TimestampValueFromTime(created),
+				value.ListValue(value.TextValue("y")),
+			},
+		),
+		newResultSet(a, names,
+			[]value.Value{
+				value.Uint64Value(3),
+				value.OptionalValue(value.TextValue("c")),
+				value.TimestampValueFromTime(created),
+				value.ListValue(value.TextValue("z")),
+			},
+		),
+		newResultSet(a, []string{"count"},
+			[]value.Value{value.Uint64Value(3)},
+		),
+	}, nil)
+}
+
+func TestWriteCSV(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	t.Run("Default", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, result.WriteCSV(context.Background(), &buf, newResult(a)))
+		require.Equal(t, ""+
+			"id,title,created,tags\n"+
+			"1,\"a,b\",2024-01-02T03:04:05Z,\"[\"\"x\"\"]\"\n"+
+			"2,,2024-01-02T03:04:05Z,\"[\"\"y\"\"]\"\n"+
+			"3,c,2024-01-02T03:04:05Z,\"[\"\"z\"\"]\"\n"+
+			"count\n"+
+			"3\n",
+			buf.String(),
+		)
+	})
+	t.Run("Options", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, result.WriteCSV(context.Background(), &buf, newResult(a),
+			result.WithCSVComma(';'),
+			result.WithoutCSVHeader(),
+			result.WithCSVNullString("NULL"),
+		))
+		require.Equal(t, ""+
+			"1;a,b;2024-01-02T03:04:05Z;\"[\"\"x\"\"]\"\n"+
+			"2;NULL;2024-01-02T03:04:05Z;\"[\"\"y\"\"]\"\n"+
+			"3;c;2024-01-02T03:04:05Z;\"[\"\"z\"\"]\"\n"+
+			"3\n",
+			buf.String(),
+		)
+	})
+}
+
+func TestWriteJSON(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	var buf bytes.Buffer
+	require.NoError(t, result.WriteJSON(context.Background(), &buf, newResult(a)))
+	require.Equal(t, ""+
+		`{"id":1,"title":"a,b","created":"2024-01-02T03:04:05Z","tags":["x"]}`+"\n"+
+		`{"id":2,"title":null,"created":"2024-01-02T03:04:05Z","tags":["y"]}`+"\n"+
+		`{"id":3,"title":"c","created":"2024-01-02T03:04:05Z","tags":["z"]}`+"\n"+
+		`{"count":3}`+"\n",
+		buf.String(),
+	)
+	t.Run("BytesAndNonFinite", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, result.WriteJSON(context.Background(), &buf, scanner.NewUnary([]*Ydb.ResultSet{
+			newResultSet(a, []string{"z", "a"},
+				[]value.Value{value.BytesValue([]byte{0xff, 0xfe}), value.DoubleValue(math.NaN())},
+				[]value.Value{value.BytesValue([]byte("ok")), value.DoubleValue(math.Inf(-1))},
+			),
+		}, nil)))
+		require.Equal(t, ""+
+			`{"z":"//4=","a":"NaN"}`+"\n"+
+			`{"z":"b2s=","a":"-Infinity"}`+"\n",
+			buf.String(),
+		)
+	})
+}
@@ -7,6 +7,7 @@ const (
 	TypeRequired
 	TypeOptional
 	TypeOptionalWithUseDefault
+	TypeAuto
 )
 
 type Value struct {
@@ -62,3 +63,20 @@ func OptionalWithDefault(columnName string, destinationValueReference interface{
 		Type:  TypeOptionalWithUseDefault,
 	}
 }
+
+// Auto makes an object with destination address for column value with name columnName
+//
+// Destination is handled same as in positional Scan: for optional columns double-pointed
+// destination is nil on NULL and single-pointed destination gets default value on NULL,
+// for required columns destination must be single-pointed.
+func Auto(columnName string, destination interface{}) Value {
+	if columnName == "" {
+		panic("columnName must be not empty")
+	}
+
+	return Value{
+		Name:  columnName,
+		Value: destination,
+		Type:  TypeAuto,
+	}
+}
@@ -3,6 +3,7 @@ package result
 import (
 	"context"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
@@ -38,6 +39,7 @@ type BaseResult interface {
 
 	// NextResultSet selects next result set in the result.
 	// columns - names of columns in the resultSet that will be scanned
+	// (matched with columns of result set according to ydb.WithColumnMatching())
 	// It returns false if there are no more result sets.
 	// Stream sets are supported.
 	// After iterate over result sets should be checked Err()
@@ -55,6 +57,16 @@ type BaseResult interface {
 	// CurrentResultSet get current result set to use ColumnCount(), RowCount() and other methods
 	CurrentResultSet() Set
 
+	// Columns returns names and types of columns of the current result set.
+	// It is available right after NextResultSet, before iterating over rows.
+	Columns() []options.Column
+
+	// CloneResultSet returns in-memory copy of the current result set as independent result.
+	// Copy contains all rows of the current result set, doesn't depend on further iteration over
+	// result (including reading of next parts of stream) and can be processed in another goroutine.
+	// Use NextResultSet() to select the result set of copy before reading rows.
+	CloneResultSet() Result
+
 	// HasNextRow reports whether result row may be advanced.
 	// It may be useful to call HasNextRow() instead of NextRow() to look ahead
 	// without advancing the result rows.
@@ -93,7 +105,9 @@ type BaseResult interface {
 	//   time.Duration
 	//   ydb.valueType
 	// For custom types implement sql.Scanner or json.Unmarshaler interface.
-	// For optional types use double pointer construction.
+	// For optional types use double pointer construction (nil on NULL) or single pointer
+	// construction (zero value on NULL or ErrNullValue if strict NULL scan is enabled with
+	// ydb.WithStrictNullScan() or options.WithStrictNullScan()).
 	// For unknown types use interface types.
 	// Supported scanning byte arrays of various length.
 	// For complex yql types: Dict, List, Tuple and own specific scanning logic
@@ -125,6 +139,15 @@ type Result interface {
 	// ResultSetCount returns number of result sets.
 	// Note that it does not work if r is the BaseResult of streaming operation.
 	ResultSetCount() int
+
+	// RowCounts returns numbers of rows in each result set.
+	RowCounts() []int
+
+	// HasTruncatedResultSet reports whether any of result sets has been truncated by server.
+	// Data query results are limited (1000 rows per result set by default), so truncated result
+	// contains partial data and full data can be read with scan query or ReadTable.
+	// Truncated flag of current result set is available with CurrentResultSet().Truncated().
+	HasTruncatedResultSet() bool
 }
 
 type StreamResult interface {
@@ -0,0 +1,66 @@
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// QueryPlan is a typed representation of query plan in JSON format returned by QueryStats.QueryPlan()
+	// (or by explain of query)
+	QueryPlan struct {
+		Meta   PlanMeta    `json:"meta"`
+		Tables []PlanTable `json:"tables,omitempty"`
+		Plan   *PlanNode   `json:"Plan,omitempty"`
+	}
+	PlanMeta struct {
+		Version string `json:"version"`
+		Type    string `json:"type"`
+	}
+	// PlanTable describes access to table in query plan
+	PlanTable struct {
+		Name   string          `json:"name"`
+		Reads  []PlanTableRead `json:"reads,omitempty"`
+		Writes []PlanTableRead `json:"writes,omitempty"`
+	}
+	PlanTableRead struct {
+		Type     string   `json:"type"`
+		Columns  []string `json:"columns,omitempty"`
+		ScanBy   []string `json:"scan_by,omitempty"`
+		LookupBy []string `json:"lookup_by,omitempty"`
+		Limit    string   `json:"limit,omitempty"`
+		Reverse  bool     `json:"reverse,omitempty"`
+	}
+	// PlanNode is a node of query plan tree
+	PlanNode struct {
+		NodeType     string                   `json:"Node Type"`
+		PlanNodeID   int                      `json:"PlanNodeId,omitempty"`
+		PlanNodeType string                   `json:"PlanNodeType,omitempty"`
+		Tables       []string                 `json:"Tables,omitempty"`
+		Operators    []map[string]interface{} `json:"Operators,omitempty"`
+		Plans        []*PlanNode              `json:"Plans,omitempty"`
+	}
+)
+
+// ParseQueryPlan parses query plan in JSON format returned by QueryStats.QueryPlan()
+func ParseQueryPlan(plan string) (*QueryPlan, error) {
+	var p QueryPlan
+	if err := json.Unmarshal([]byte(plan), &p); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("parse query plan: %w", err))
+	}
+
+	return &p, nil
+}
+
+// Walk calls f for node n and all its descendants in depth-first order
+func (n *PlanNode) Walk(f func(node *PlanNode)) {
+	if n == nil {
+		return
+	}
+	f(n)
+	for _, child := range n.Plans {
+		child.Walk(f)
+	}
+}
@@ -0,0 +1,51 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryPlan(t *testing.T) {
+	plan, err := ParseQueryPlan(`{
+		"meta": {"version": "0.2", "type": "query"},
+		"tables": [{
+			"name": "/local/series",
+			"reads": [{"type": "FullScan", "scan_by": ["series_id"], "columns": ["series_id", "title"]}]
+		}],
+		"Plan": {
+			"Node Type": "Query",
+			"PlanNodeType": "Query",
+			"Plans": [{
+				"Node Type": "ResultSet",
+				"PlanNodeId": 2,
+				"PlanNodeType": "ResultSet",
+				"Plans": [{
+					"Node Type": "TableFullScan",
+					"PlanNodeId": 1,
+					"Tables": ["series"],
+					"Operators": [{"Name": "TableFullScan", "Table": "series"}]
+				}]
+			}]
+		}
+	}`)
+	require.NoError(t, err)
+	require.Equal(t, PlanMeta{Version: "0.2", Type: "query"}, plan.Meta)
+	require.Equal(t, []PlanTable{{
+		Name: "/local/series",
+		Reads: []PlanTableRead{{
+			Type:    "FullScan",
+			Columns: []string{"series_id", "title"},
+			ScanBy:  []string{"series_id"},
+		}},
+	}}, plan.Tables)
+	var nodes []string
+	plan.Plan.Walk(func(node *PlanNode) {
+		nodes = append(nodes, node.NodeType)
+	})
+	require.Equal(t, []string{"Query", "ResultSet", "TableFullScan"}, nodes)
+	require.Equal(t, "series", plan.Plan.Plans[0].Plans[0].Operators[0]["Table"])
+
+	_, err = ParseQueryPlan("not a json")
+	require.Error(t, err)
+}
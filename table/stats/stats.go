@@ -17,6 +17,7 @@ type QueryPhase interface {
 type QueryStats interface {
 	ProcessCPUTime() time.Duration
 	Compilation() (c *CompilationStats)
+	// QueryPlan returns query plan in JSON format (use ParseQueryPlan for typed plan)
 	QueryPlan() string
 	QueryAST() string
 	TotalCPUTime() time.Duration
@@ -40,6 +41,8 @@ type TableAccess struct {
 	Reads   OperationStats
 	Updates OperationStats
 	Deletes OperationStats
+
+	PartitionsCount uint64
 }
 
 type OperationStats struct {
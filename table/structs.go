@@ -0,0 +1,658 @@
+package table
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var (
+	errRowsIsNotASliceOfStructs = errors.New("rows is not a slice of structs")
+	errParamsIsNotAStruct       = errors.New("params is not a struct")
+	errUnsupportedFieldType     = errors.New("unsupported field type")
+	errUnknownTypeName          = errors.New("unknown type name")
+	errValueOutOfRange          = errors.New("value out of range")
+
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+	typeOfUUID     = reflect.TypeOf([16]byte{})
+	typeOfBytes    = reflect.TypeOf([]byte{})
+	typeOfValue    = reflect.TypeOf((*types.Value)(nil)).Elem()
+	typeOfValuer   = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// UpsertStructs upserts rows from slice of structs (or pointers to structs) with BulkUpsert
+//
+// Column names are taken from `ydb:"column"` field tags or from field names if tag is not defined.
+// Fields with tag `ydb:"-"` and unexported fields are skipped. YDB types are inferred from Go types:
+// pointers are mapped to optional types, int and uint to Int64 and Uint64, string to Utf8,
+// []byte to String, [16]byte to UUID, time.Time to Timestamp and time.Duration to Interval,
+// slices to List and nested structs to Struct.
+// Inferred type can be overridden with YQL type name after comma, e.g. `ydb:"created,Date"`,
+// integer values out of range of overridden type are rejected with error.
+// Self-referential struct types are not supported.
+// Fields of types.Value type are passed as is, values of types implementing driver.Valuer
+// are converted with Value method.
+//
+// Empty rows slice is a no-op.
+func UpsertStructs(
+	ctx context.Context, s Session, path string, rows interface{}, opts ...options.BulkUpsertOption,
+) error {
+	list, err := structsToList(rows)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if list == nil {
+		return nil
+	}
+
+	return s.BulkUpsert(ctx, path, list, opts...)
+}
+
+// ParamsFromStruct makes query parameters from fields of struct (or pointer to struct)
+//
+// Parameter names are taken from `ydb:"name"` field tags or from field names if tag is not defined,
+// '$' prefix is added automatically. Types of parameters are inferred from Go types with the same
+// rules as in UpsertStructs and can be overridden with YQL type name after comma, e.g.
+// `ydb:"release_date,Date"`. Slices of structs are mapped to List<Struct<...>>, so the
+// list of rows for upsert can be passed as single field.
+func ParamsFromStruct(v interface{}) (*QueryParameters, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: nil %T", errParamsIsNotAStruct, v))
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errParamsIsNotAStruct, v))
+	}
+	opts := make([]ParameterOption, 0, rv.NumField())
+	visited := visitedTypes{rv.Type(): {}}
+	err := rangeFields(rv.Type(), func(i int, name, typeName string) error {
+		fieldValue, err := toValue(rv.Field(i), typeName, visited)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		opts = append(opts, ValueParam(name, fieldValue))
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return NewQueryParameters(opts...), nil
+}
+
+// ParamsFromMap makes query parameters from map of parameter names to Go values
+//
+// '$' prefix is added to parameter names automatically. Types of parameters are inferred from Go types
+// with the same rules as in UpsertStructs. For ambiguous cases (e.g. time.Time for Date parameter or
+// nil value) YQL type names of parameters can be provided with typeNames, e.g. {"release_date": "Date"}.
+// Nil values are mapped to NULL of type from typeNames. Parameters are ordered by name.
+func ParamsFromMap(m map[string]interface{}, typeNames map[string]string) (*QueryParameters, error) {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.TrimPrefix(names[i], "$") < strings.TrimPrefix(names[j], "$")
+	})
+	opts := make([]ParameterOption, 0, len(names))
+	for _, name := range names {
+		typeName, has := typeNames[strings.TrimPrefix(name, "$")]
+		if !has {
+			typeName = typeNames["$"+strings.TrimPrefix(name, "$")]
+		}
+		paramValue, err := anyToValue(m[name], typeName)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("param %q: %w", name, err))
+		}
+		opts = append(opts, ValueParam(name, paramValue))
+	}
+
+	return NewQueryParameters(opts...), nil
+}
+
+func anyToValue(v interface{}, typeName string) (types.Value, error) {
+	if v != nil {
+		return toValue(reflect.ValueOf(v), typeName, visitedTypes{})
+	}
+	if typeName == "" {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: nil without type name", errUnsupportedFieldType))
+	}
+	c, err := lookupTypeConverter(typeName)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return types.NullValue(c.t), nil
+}
+
+func structsToList(rows interface{}) (types.Value, error) {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errRowsIsNotASliceOfStructs, rows))
+	}
+	t := v.Type().Elem()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errRowsIsNotASliceOfStructs, rows))
+	}
+	if v.Len() == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	items := make([]types.Value, v.Len())
+	visited := visitedTypes{}
+	for i := range items {
+		item := v.Index(i)
+		if item.Kind() == reflect.Pointer {
+			if item.IsNil() {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("%w: nil item at %d", errRowsIsNotASliceOfStructs, i))
+			}
+			item = item.Elem()
+		}
+		structValue, err := toStructValue(item, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		items[i] = structValue
+	}
+
+	return types.ListValue(items...), nil
+}
+
+// rangeFields calls f for each exported and not skipped field of struct type t
+func rangeFields(t reflect.Type, f func(i int, name, typeName string) error) error {
+	for i := 0; i < t.NumField(); i++ {
+		name, typeName, ok := value.StructFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		if err := f(i, name, typeName); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("field %q: %w", t.Field(i).Name, err))
+		}
+	}
+
+	return nil
+}
+
+// visitedTypes is a set of struct types on the path from root to current value (or type).
+// YDB types cannot be recursive, so self-referential struct types are not supported
+type visitedTypes map[reflect.Type]struct{}
+
+func (visited visitedTypes) enter(t reflect.Type) error {
+	if _, has := visited[t]; has {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: recursive type %s", errUnsupportedFieldType, t))
+	}
+	visited[t] = struct{}{}
+
+	return nil
+}
+
+func (visited visitedTypes) leave(t reflect.Type) {
+	delete(visited, t)
+}
+
+func toStructValue(v reflect.Value, visited visitedTypes) (types.Value, error) {
+	if err := visited.enter(v.Type()); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer visited.leave(v.Type())
+
+	fields := make([]types.StructValueOption, 0, v.NumField())
+	err := rangeFields(v.Type(), func(i int, name, typeName string) error {
+		fieldValue, err := toValue(v.Field(i), typeName, visited)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		fields = append(fields, types.StructFieldValue(name, fieldValue))
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return types.StructValue(fields...), nil
+}
+
+func toStructType(t reflect.Type, visited visitedTypes) (types.Type, error) {
+	if err := visited.enter(t); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer visited.leave(t)
+
+	fields := make([]types.StructOption, 0, t.NumField())
+	err := rangeFields(t, func(i int, name, typeName string) error {
+		fieldType, err := toType(t.Field(i).Type, typeName, visited)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		fields = append(fields, types.StructField(name, fieldType))
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return types.Struct(fields...), nil
+}
+
+// typeConverter makes value of YQL type overridden with field tag.
+// from returns errUnsupportedFieldType for incompatible Go types and errValueOutOfRange
+// for values which do not fit into YQL type
+type typeConverter struct {
+	t    types.Type
+	from func(v reflect.Value) (types.Value, error)
+}
+
+func fromTime(f func(time.Time) types.Value) func(v reflect.Value) (types.Value, error) {
+	return func(v reflect.Value) (types.Value, error) {
+		if v.Type() != typeOfTime {
+			return nil, errUnsupportedFieldType
+		}
+
+		return f(v.Interface().(time.Time)), nil //nolint:forcetypeassert
+	}
+}
+
+func fromText(f func(string) types.Value) func(v reflect.Value) (types.Value, error) {
+	return func(v reflect.Value) (types.Value, error) {
+		switch {
+		case v.Kind() == reflect.String:
+			return f(v.String()), nil
+		case v.Type() == typeOfBytes:
+			return f(string(v.Bytes())), nil
+		default:
+			return nil, errUnsupportedFieldType
+		}
+	}
+}
+
+// fromInt makes converter of integer Go values into signed YQL type with range [minValue, maxValue]
+func fromInt(minValue, maxValue int64, f func(int64) types.Value) func(v reflect.Value) (types.Value, error) {
+	return func(v reflect.Value) (types.Value, error) {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if x := v.Int(); x < minValue || x > maxValue {
+				return nil, fmt.Errorf("%w: %d", errValueOutOfRange, x)
+			}
+
+			return f(v.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if x := v.Uint(); x > uint64(maxValue) {
+				return nil, fmt.Errorf("%w: %d", errValueOutOfRange, x)
+			}
+
+			return f(int64(v.Uint())), nil
+		default:
+			return nil, errUnsupportedFieldType
+		}
+	}
+}
+
+// fromUint makes converter of integer Go values into unsigned YQL type with range [0, maxValue]
+func fromUint(maxValue uint64, f func(uint64) types.Value) func(v reflect.Value) (types.Value, error) {
+	return func(v reflect.Value) (types.Value, error) {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if x := v.Int(); x < 0 || uint64(x) > maxValue {
+				return nil, fmt.Errorf("%w: %d", errValueOutOfRange, x)
+			}
+
+			return f(uint64(v.Int())), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if x := v.Uint(); x > maxValue {
+				return nil, fmt.Errorf("%w: %d", errValueOutOfRange, x)
+			}
+
+			return f(v.Uint()), nil
+		default:
+			return nil, errUnsupportedFieldType
+		}
+	}
+}
+
+func fromFloat(f func(float64) types.Value) func(v reflect.Value) (types.Value, error) {
+	return func(v reflect.Value) (types.Value, error) {
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return f(v.Float()), nil
+		default:
+			return nil, errUnsupportedFieldType
+		}
+	}
+}
+
+//nolint:gochecknoglobals
+var typeConverters = map[string]typeConverter{
+	"bool": {types.TypeBool, func(v reflect.Value) (types.Value, error) {
+		if v.Kind() != reflect.Bool {
+			return nil, errUnsupportedFieldType
+		}
+
+		return types.BoolValue(v.Bool()), nil
+	}},
+	"int8": {types.TypeInt8, fromInt(math.MinInt8, math.MaxInt8, func(v int64) types.Value {
+		return types.Int8Value(int8(v))
+	})},
+	"int16": {types.TypeInt16, fromInt(math.MinInt16, math.MaxInt16, func(v int64) types.Value {
+		return types.Int16Value(int16(v))
+	})},
+	"int32": {types.TypeInt32, fromInt(math.MinInt32, math.MaxInt32, func(v int64) types.Value {
+		return types.Int32Value(int32(v))
+	})},
+	"int64": {types.TypeInt64, fromInt(math.MinInt64, math.MaxInt64, types.Int64Value)},
+	"uint8": {types.TypeUint8, fromUint(math.MaxUint8, func(v uint64) types.Value {
+		return types.Uint8Value(uint8(v))
+	})},
+	"uint16": {types.TypeUint16, fromUint(math.MaxUint16, func(v uint64) types.Value {
+		return types.Uint16Value(uint16(v))
+	})},
+	"uint32": {types.TypeUint32, fromUint(math.MaxUint32, func(v uint64) types.Value {
+		return types.Uint32Value(uint32(v))
+	})},
+	"uint64":      {types.TypeUint64, fromUint(math.MaxUint64, types.Uint64Value)},
+	"float":       {types.TypeFloat, fromFloat(func(v float64) types.Value { return types.FloatValue(float32(v)) })},
+	"double":      {types.TypeDouble, fromFloat(types.DoubleValue)},
+	"date":        {types.TypeDate, fromTime(types.DateValueFromTime)},
+	"datetime":    {types.TypeDatetime, fromTime(types.DatetimeValueFromTime)},
+	"timestamp":   {types.TypeTimestamp, fromTime(types.TimestampValueFromTime)},
+	"tzdate":      {types.TypeTzDate, fromTime(types.TzDateValueFromTime)},
+	"tzdatetime":  {types.TypeTzDatetime, fromTime(types.TzDatetimeValueFromTime)},
+	"tztimestamp": {types.TypeTzTimestamp, fromTime(types.TzTimestampValueFromTime)},
+	"interval": {types.TypeInterval, func(v reflect.Value) (types.Value, error) {
+		if v.Type() != typeOfDuration {
+			return nil, errUnsupportedFieldType
+		}
+
+		return types.IntervalValueFromDuration(time.Duration(v.Int())), nil
+	}},
+	"utf8":         {types.TypeText, fromText(types.TextValue)},
+	"text":         {types.TypeText, fromText(types.TextValue)},
+	"string":       {types.TypeBytes, fromText(types.BytesValueFromString)},
+	"bytes":        {types.TypeBytes, fromText(types.BytesValueFromString)},
+	"json":         {types.TypeJSON, fromText(types.JSONValue)},
+	"jsondocument": {types.TypeJSONDocument, fromText(types.JSONDocumentValue)},
+	"yson":         {types.TypeYSON, fromText(types.YSONValue)},
+	"dynumber":     {types.TypeDyNumber, fromText(types.DyNumberValue)},
+	"uuid": {types.TypeUUID, func(v reflect.Value) (types.Value, error) {
+		if v.Type() != typeOfUUID {
+			return nil, errUnsupportedFieldType
+		}
+
+		return types.UUIDValue(v.Interface().([16]byte)), nil //nolint:forcetypeassert
+	}},
+}
+
+func lookupTypeConverter(typeName string) (typeConverter, error) {
+	c, has := typeConverters[strings.ToLower(typeName)]
+	if !has {
+		return c, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errUnknownTypeName, typeName))
+	}
+
+	return c, nil
+}
+
+// isList reports whether Go type t is mapped to YDB list
+func isList(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice:
+		return t != typeOfBytes
+	case reflect.Array:
+		return t != typeOfUUID
+	default:
+		return false
+	}
+}
+
+// isNil reports whether v is nil value of nillable kind (IsNil panics for other kinds)
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// toValue makes YDB value from Go value. Non-empty typeName overrides type of
+// leaf values (through pointers and slices).
+//
+//nolint:gocyclo,funlen
+func toValue(v reflect.Value, typeName string, visited visitedTypes) (types.Value, error) {
+	if v.Type().Implements(typeOfValue) {
+		if isNil(v) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: nil %s", errUnsupportedFieldType, v.Type()))
+		}
+
+		return v.Interface().(types.Value), nil //nolint:forcetypeassert
+	}
+	if valuer, ok := asValuer(v); ok {
+		return valuerToValue(valuer, typeName, visited)
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			t, err := toType(v.Type().Elem(), typeName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return types.NullValue(t), nil
+		}
+		inner, err := toValue(v.Elem(), typeName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.OptionalValue(inner), nil
+	}
+	if typeName != "" && !isList(v.Type()) {
+		c, err := lookupTypeConverter(typeName)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		value, err := c.from(v)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf(
+				"%w: %s cannot be converted to %s", err, v.Type(), c.t.Yql(),
+			))
+		}
+
+		return value, nil
+	}
+	switch v.Type() {
+	case typeOfTime:
+		return types.TimestampValueFromTime(v.Interface().(time.Time)), nil //nolint:forcetypeassert
+	case typeOfDuration:
+		return types.IntervalValueFromDuration(time.Duration(v.Int())), nil
+	case typeOfUUID:
+		return types.UUIDValue(v.Interface().([16]byte)), nil //nolint:forcetypeassert
+	case typeOfBytes:
+		return types.BytesValue(v.Bytes()), nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return types.BoolValue(v.Bool()), nil
+	case reflect.Int8:
+		return types.Int8Value(int8(v.Int())), nil
+	case reflect.Int16:
+		return types.Int16Value(int16(v.Int())), nil
+	case reflect.Int32:
+		return types.Int32Value(int32(v.Int())), nil
+	case reflect.Int, reflect.Int64:
+		return types.Int64Value(v.Int()), nil
+	case reflect.Uint8:
+		return types.Uint8Value(uint8(v.Uint())), nil
+	case reflect.Uint16:
+		return types.Uint16Value(uint16(v.Uint())), nil
+	case reflect.Uint32:
+		return types.Uint32Value(uint32(v.Uint())), nil
+	case reflect.Uint, reflect.Uint64:
+		return types.Uint64Value(v.Uint()), nil
+	case reflect.Float32:
+		return types.FloatValue(float32(v.Float())), nil
+	case reflect.Float64:
+		return types.DoubleValue(v.Float()), nil
+	case reflect.String:
+		return types.TextValue(v.String()), nil
+	case reflect.Struct:
+		return toStructValue(v, visited)
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			t, err := toType(v.Type(), typeName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return types.ZeroValue(t), nil
+		}
+		items := make([]types.Value, v.Len())
+		for i := range items {
+			item, err := toValue(v.Index(i), typeName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			items[i] = item
+		}
+
+		return types.ListValue(items...), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedFieldType, v.Type()))
+	}
+}
+
+// asValuer returns driver.Valuer implemented by v or by pointer to v.
+// Nil pointers are not treated as valuers and are mapped to NULL.
+func asValuer(v reflect.Value) (driver.Valuer, bool) {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, false
+	}
+	if v.Type().Implements(typeOfValuer) {
+		return v.Interface().(driver.Valuer), true //nolint:forcetypeassert
+	}
+	if v.Kind() != reflect.Pointer && reflect.PointerTo(v.Type()).Implements(typeOfValuer) {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+
+		return ptr.Interface().(driver.Valuer), true //nolint:forcetypeassert
+	}
+
+	return nil, false
+}
+
+func valuerToValue(valuer driver.Valuer, typeName string, visited visitedTypes) (types.Value, error) {
+	v, err := valuer.Value()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("driver.Valuer error: %w", err))
+	}
+	if v == nil {
+		if typeName == "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf(
+				"%w: nil from %T without type name", errUnsupportedFieldType, valuer,
+			))
+		}
+		c, err := lookupTypeConverter(typeName)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NullValue(c.t), nil
+	}
+
+	return toValue(reflect.ValueOf(v), typeName, visited)
+}
+
+// toType makes YDB type from Go type. Non-empty typeName overrides type of
+// leaf values (through pointers and slices).
+//
+//nolint:gocyclo,funlen
+func toType(t reflect.Type, typeName string, visited visitedTypes) (types.Type, error) {
+	if t.Kind() == reflect.Pointer {
+		inner, err := toType(t.Elem(), typeName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.Optional(inner), nil
+	}
+	if typeName != "" && !isList(t) {
+		c, err := lookupTypeConverter(typeName)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return c.t, nil
+	}
+	if valuer, ok := asValuer(reflect.New(t).Elem()); ok {
+		v, err := valuerToValue(valuer, typeName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return v.Type(), nil
+	}
+	switch t {
+	case typeOfTime:
+		return types.TypeTimestamp, nil
+	case typeOfDuration:
+		return types.TypeInterval, nil
+	case typeOfUUID:
+		return types.TypeUUID, nil
+	case typeOfBytes:
+		return types.TypeBytes, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return types.TypeBool, nil
+	case reflect.Int8:
+		return types.TypeInt8, nil
+	case reflect.Int16:
+		return types.TypeInt16, nil
+	case reflect.Int32:
+		return types.TypeInt32, nil
+	case reflect.Int, reflect.Int64:
+		return types.TypeInt64, nil
+	case reflect.Uint8:
+		return types.TypeUint8, nil
+	case reflect.Uint16:
+		return types.TypeUint16, nil
+	case reflect.Uint32:
+		return types.TypeUint32, nil
+	case reflect.Uint, reflect.Uint64:
+		return types.TypeUint64, nil
+	case reflect.Float32:
+		return types.TypeFloat, nil
+	case reflect.Float64:
+		return types.TypeDouble, nil
+	case reflect.String:
+		return types.TypeText, nil
+	case reflect.Struct:
+		return toStructType(t, visited)
+	case reflect.Slice, reflect.Array:
+		inner, err := toType(t.Elem(), typeName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.List(inner), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedFieldType, t))
+	}
+}
@@ -0,0 +1,390 @@
+package table_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type bulkUpsertSession struct {
+	table.Session
+
+	rows types.Value
+}
+
+func (s *bulkUpsertSession) BulkUpsert(
+	ctx context.Context, table string, rows types.Value, opts ...options.BulkUpsertOption,
+) error {
+	s.rows = rows
+
+	return nil
+}
+
+func TestUpsertStructs(t *testing.T) {
+	type row struct {
+		ID       uint64 `ydb:"id"`
+		Title    string `ydb:"title"`
+		Payload  []byte `ydb:"payload"`
+		Score    *float64
+		Created  time.Time `ydb:"created"`
+		Ignored  string    `ydb:"-"`
+		internal int
+	}
+	score := 1.5
+	created := time.Unix(1, 0)
+	for _, tt := range []struct {
+		name string
+		rows interface{}
+		exp  types.Value
+		err  bool
+	}{
+		{
+			name: "Structs",
+			rows: []row{
+				{ID: 1, Title: "a", Payload: []byte("p"), Score: &score, Created: created, Ignored: "x", internal: 1},
+				{ID: 2, Title: "b", Created: created},
+			},
+			exp: types.ListValue(
+				types.StructValue(
+					types.StructFieldValue("id", types.Uint64Value(1)),
+					types.StructFieldValue("title", types.TextValue("a")),
+					types.StructFieldValue("payload", types.BytesValue([]byte("p"))),
+					types.StructFieldValue("Score", types.OptionalValue(types.DoubleValue(score))),
+					types.StructFieldValue("created", types.TimestampValueFromTime(created)),
+				),
+				types.StructValue(
+					types.StructFieldValue("id", types.Uint64Value(2)),
+					types.StructFieldValue("title", types.TextValue("b")),
+					types.StructFieldValue("payload", types.BytesValue(nil)),
+					types.StructFieldValue("Score", types.NullValue(types.TypeDouble)),
+					types.StructFieldValue("created", types.TimestampValueFromTime(created)),
+				),
+			),
+		},
+		{
+			name: "PointersToStructs",
+			rows: []*struct {
+				ID    int64       `ydb:"id"`
+				Value types.Value `ydb:"value"`
+			}{
+				{ID: 1, Value: types.JSONValue(`{}`)},
+			},
+			exp: types.ListValue(
+				types.StructValue(
+					types.StructFieldValue("id", types.Int64Value(1)),
+					types.StructFieldValue("value", types.JSONValue(`{}`)),
+				),
+			),
+		},
+		{
+			name: "Empty",
+			rows: []row{},
+		},
+		{
+			name: "NotASlice",
+			rows: row{},
+			err:  true,
+		},
+		{
+			name: "NotAStruct",
+			rows: []int{1, 2, 3},
+			err:  true,
+		},
+		{
+			name: "UnsupportedType",
+			rows: []struct {
+				Values map[string]int
+			}{
+				{},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &bulkUpsertSession{}
+			err := table.UpsertStructs(context.Background(), s, "/local/test", tt.rows)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			if tt.exp == nil {
+				require.Nil(t, s.rows)
+
+				return
+			}
+			require.Equal(t, tt.exp.Yql(), s.rows.Yql())
+		})
+	}
+}
+
+type recursiveNode struct {
+	Value    int64            `ydb:"value"`
+	Next     *recursiveNode   `ydb:"next"`
+	Children []*recursiveNode `ydb:"children"`
+}
+
+func TestParamsFromStruct(t *testing.T) {
+	type episode struct {
+		ID       uint64    `ydb:"episode_id"`
+		Title    string    `ydb:"title"`
+		AirDate  time.Time `ydb:"air_date,Date"`
+		Comments *string   `ydb:"comments"`
+	}
+	airDate := time.Date(2006, time.February, 3, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name   string
+		params interface{}
+		exp    *table.QueryParameters
+		err    bool
+	}{
+		{
+			name: "Struct",
+			params: struct {
+				SeriesID uint64 `ydb:"series_id"`
+				Title    string
+				Info     []byte   `ydb:"info,Json"`
+				Tags     []string `ydb:"tags"`
+				Rating   *float64 `ydb:"rating"`
+				Skipped  string   `ydb:"-"`
+			}{
+				SeriesID: 1,
+				Title:    "IT Crowd",
+				Info:     []byte(`{}`),
+			},
+			exp: table.NewQueryParameters(
+				table.ValueParam("$series_id", types.Uint64Value(1)),
+				table.ValueParam("$Title", types.TextValue("IT Crowd")),
+				table.ValueParam("$info", types.JSONValue(`{}`)),
+				table.ValueParam("$tags", types.ZeroValue(types.List(types.TypeText))),
+				table.ValueParam("$rating", types.NullValue(types.TypeDouble)),
+			),
+		},
+		{
+			name: "NestedListOfStructs",
+			params: &struct {
+				Episodes []episode `ydb:"episodes"`
+				Empty    []episode `ydb:"empty"`
+			}{
+				Episodes: []episode{
+					{ID: 1, Title: "Yesterday's Jam", AirDate: airDate},
+				},
+			},
+			exp: table.NewQueryParameters(
+				table.ValueParam("$episodes", types.ListValue(
+					types.StructValue(
+						types.StructFieldValue("episode_id", types.Uint64Value(1)),
+						types.StructFieldValue("title", types.TextValue("Yesterday's Jam")),
+						types.StructFieldValue("air_date", types.DateValueFromTime(airDate)),
+						types.StructFieldValue("comments", types.NullValue(types.TypeText)),
+					),
+				)),
+				table.ValueParam("$empty", types.ZeroValue(types.List(types.Struct(
+					types.StructField("episode_id", types.TypeUint64),
+					types.StructField("title", types.TypeText),
+					types.StructField("air_date", types.TypeDate),
+					types.StructField("comments", types.Optional(types.TypeText)),
+				)))),
+			),
+		},
+		{
+			name:   "NotAStruct",
+			params: []episode{},
+			err:    true,
+		},
+		{
+			name: "UnknownTypeName",
+			params: struct {
+				ID uint64 `ydb:"id,Unknown"`
+			}{},
+			err: true,
+		},
+		{
+			name: "InconvertibleType",
+			params: struct {
+				ID uint64 `ydb:"id,Date"`
+			}{},
+			err: true,
+		},
+		{
+			name: "NarrowedType",
+			params: struct {
+				A int64  `ydb:"a,Int8"`
+				B uint64 `ydb:"b,Int64"`
+				C int    `ydb:"c,Uint8"`
+			}{A: -128, B: 1, C: 255},
+			exp: table.NewQueryParameters(
+				table.ValueParam("$a", types.Int8Value(-128)),
+				table.ValueParam("$b", types.Int64Value(1)),
+				table.ValueParam("$c", types.Uint8Value(255)),
+			),
+		},
+		{
+			name: "OutOfRange",
+			params: struct {
+				A int64 `ydb:"a,Int8"`
+			}{A: 300},
+			err: true,
+		},
+		{
+			name: "NegativeToUnsigned",
+			params: struct {
+				A int `ydb:"a,Uint64"`
+			}{A: -1},
+			err: true,
+		},
+		{
+			name: "UnsignedOverflow",
+			params: struct {
+				A uint64 `ydb:"a,Int64"`
+			}{A: math.MaxUint64},
+			err: true,
+		},
+		{
+			name:   "RecursiveType",
+			params: &recursiveNode{Value: 1, Next: &recursiveNode{Value: 2}},
+			err:    true,
+		},
+		{
+			name: "RecursiveSlice",
+			params: struct {
+				Nodes []recursiveNode `ydb:"nodes"`
+			}{},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := table.ParamsFromStruct(tt.params)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.exp.String(), params.String())
+		})
+	}
+}
+
+func TestParamsFromMap(t *testing.T) {
+	releaseDate := time.Date(2006, time.February, 3, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name      string
+		params    map[string]interface{}
+		typeNames map[string]string
+		exp       *table.QueryParameters
+		err       bool
+	}{
+		{
+			name: "Inferred",
+			params: map[string]interface{}{
+				"$series_id": uint64(1),
+				"title":      "IT Crowd",
+				"tags":       []string{"comedy"},
+				"created":    releaseDate,
+			},
+			exp: table.NewQueryParameters(
+				table.ValueParam("$created", types.TimestampValueFromTime(releaseDate)),
+				table.ValueParam("$series_id", types.Uint64Value(1)),
+				table.ValueParam("$tags", types.ListValue(types.TextValue("comedy"))),
+				table.ValueParam("$title", types.TextValue("IT Crowd")),
+			),
+		},
+		{
+			name: "Values",
+			params: map[string]interface{}{
+				"series_id": types.Uint64Value(1),
+				"title":     types.TextValue("IT Crowd"),
+				"comment":   types.NullValue(types.TypeText),
+			},
+			exp: table.NewQueryParameters(
+				table.ValueParam("$comment", types.NullValue(types.TypeText)),
+				table.ValueParam("$series_id", types.Uint64Value(1)),
+				table.ValueParam("$title", types.TextValue("IT Crowd")),
+			),
+		},
+		{
+			name: "Overridden",
+			params: map[string]interface{}{
+				"release_date": releaseDate,
+				"$info":        `{}`,
+				"comments":     nil,
+			},
+			typeNames: map[string]string{
+				"$release_date": "Date",
+				"info":          "Json",
+				"comments":      "Utf8",
+			},
+			exp: table.NewQueryParameters(
+				table.ValueParam("$comments", types.NullValue(types.TypeText)),
+				table.ValueParam("$info", types.JSONValue(`{}`)),
+				table.ValueParam("$release_date", types.DateValueFromTime(releaseDate)),
+			),
+		},
+		{
+			name: "Valuer",
+			params: map[string]interface{}{
+				"price":    money(1250),
+				"title":    sql.NullString{String: "IT Crowd", Valid: true},
+				"comments": sql.NullString{},
+			},
+			typeNames: map[string]string{
+				"comments": "Utf8",
+			},
+			exp: table.NewQueryParameters(
+				table.ValueParam("$comments", types.NullValue(types.TypeText)),
+				table.ValueParam("$price", types.TextValue("12.50")),
+				table.ValueParam("$title", types.TextValue("IT Crowd")),
+			),
+		},
+		{
+			name: "NilValuerWithoutTypeName",
+			params: map[string]interface{}{
+				"comments": sql.NullString{},
+			},
+			err: true,
+		},
+		{
+			name: "NilWithoutTypeName",
+			params: map[string]interface{}{
+				"comments": nil,
+			},
+			err: true,
+		},
+		{
+			name: "UnsupportedType",
+			params: map[string]interface{}{
+				"ch": make(chan int),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := table.ParamsFromMap(tt.params, tt.typeNames)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.exp.String(), params.String())
+		})
+	}
+}
+
+type money int64
+
+func (m money) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d.%02d", m/100, m%100), nil
+}
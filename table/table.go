@@ -4,8 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
@@ -14,6 +16,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -67,6 +70,27 @@ type Client interface {
 	// If op TxOperation return non nil - transaction will be rollback
 	// Warning: if context without deadline or cancellation func than DoTx can run indefinitely
 	DoTx(ctx context.Context, op TxOperation, opts ...Option) error
+
+	// BulkUpsert upserts a batch of rows non-transactionally.
+	//
+	// BulkUpsert does not require a session and retries on retryable errors because
+	// upsert of the same rows is idempotent.
+	// Returns success only when all rows were successfully upserted. In case of an error some rows might
+	// be upserted and some might not. Server issues are available through ydb.IterateByIssues on returned error.
+	BulkUpsert(ctx context.Context, table string, data BulkUpsertData, opts ...Option) error
+
+	// Warmup creates sessions in the pool until it has at least n idle sessions
+	// (but no more than pool size limit).
+	//
+	// Warmup may be used at application start for avoiding latency spike on the first requests.
+	Warmup(ctx context.Context, n int) error
+
+	// Drain stops giving out sessions, waits for sessions in use to be returned
+	// (no longer than ctx allows) and then closes all sessions.
+	//
+	// Drain may be used for graceful shutdown of application for avoiding abort of active transactions.
+	// Operations started after Drain call return error.
+	Drain(ctx context.Context) error
 }
 
 type SessionStatus = string
@@ -477,6 +501,14 @@ func NewQueryParameters(opts ...ParameterOption) *QueryParameters {
 	return &qp
 }
 
+// Named makes destination for scanning of column with name columnName with result.ScanNamed
+//
+// Scanning by column names is robust to reordering of columns in SELECT list.
+// Destination is handled same as in positional result.Scan (see named.Auto).
+func Named(columnName string, destination interface{}) named.Value {
+	return named.Auto(columnName, destination)
+}
+
 func ValueParam(name string, v value.Value) ParameterOption {
 	switch len(name) {
 	case 0:
@@ -491,12 +523,14 @@ func ValueParam(name string, v value.Value) ParameterOption {
 }
 
 type Options struct {
-	Label           string
-	Idempotent      bool
-	TxSettings      *TransactionSettings
-	TxCommitOptions []options.CommitTransactionOption
-	RetryOptions    []retry.Option
-	Trace           *trace.Table
+	Label            string
+	Idempotent       bool
+	TxSettings       *TransactionSettings
+	TxCommitOptions  []options.CommitTransactionOption
+	RetryOptions     []retry.Option
+	ReuseSession     bool
+	InferIdempotency bool
+	Trace            *trace.Table
 }
 
 type Option interface {
@@ -540,6 +574,37 @@ func WithIdempotent() retryOptionsOption {
 	return []retry.Option{retry.WithIdempotent(true)}
 }
 
+var _ Option = reuseSessionOption(false)
+
+type reuseSessionOption bool
+
+func (reuse reuseSessionOption) ApplyTableOption(opts *Options) {
+	opts.ReuseSession = bool(reuse)
+}
+
+// WithSessionReuse controls whether retry attempts of Do and DoTx reuse the
+// same session while it stays valid (true) or take a fresh session from the
+// pool on every attempt (false, default).
+func WithSessionReuse(reuse bool) reuseSessionOption {
+	return reuseSessionOption(reuse)
+}
+
+var _ Option = inferIdempotencyOption{}
+
+type inferIdempotencyOption struct{}
+
+func (inferIdempotencyOption) ApplyTableOption(opts *Options) {
+	opts.InferIdempotency = true
+}
+
+// WithIdempotencyInference enables inferring of idempotency from operation kind.
+// Errors of attempts which made only read-only calls (read-only transactions, scan queries,
+// ReadTable, ReadRows, DescribeTable) are retried as errors of idempotent operation.
+// Attempts which may change data are retried according to WithIdempotent option.
+func WithIdempotencyInference() inferIdempotencyOption {
+	return inferIdempotencyOption{}
+}
+
 var _ Option = txSettingsOption{}
 
 type txSettingsOption struct {
@@ -579,3 +644,158 @@ func (opt traceOption) ApplyTableOption(opts *Options) {
 func WithTrace(t trace.Table) traceOption { //nolint:gocritic
 	return traceOption{t: &t}
 }
+
+// BulkUpsertData is a payload of Client.BulkUpsert request
+type BulkUpsertData interface {
+	ToYDB(a *allocator.Allocator, tableName string) (*Ydb_Table.BulkUpsertRequest, error)
+}
+
+var _ BulkUpsertData = bulkUpsertRows{}
+
+type bulkUpsertRows struct {
+	rows value.Value
+}
+
+func (data bulkUpsertRows) ToYDB(a *allocator.Allocator, tableName string) (*Ydb_Table.BulkUpsertRequest, error) {
+	return &Ydb_Table.BulkUpsertRequest{
+		Table: tableName,
+		Rows:  value.ToYDB(data.rows, a),
+	}, nil
+}
+
+// BulkUpsertDataRows makes BulkUpsertData from list of ydb struct values
+func BulkUpsertDataRows(rows value.Value) bulkUpsertRows {
+	return bulkUpsertRows{
+		rows: rows,
+	}
+}
+
+var _ BulkUpsertData = bulkUpsertCsv{}
+
+type (
+	bulkUpsertCsv struct {
+		data []byte
+		opts []csvFormatOption
+	}
+	csvFormatOption interface {
+		applyCsvFormatOption(settings *Ydb_Formats.CsvSettings)
+	}
+)
+
+func (data bulkUpsertCsv) ToYDB(a *allocator.Allocator, tableName string) (*Ydb_Table.BulkUpsertRequest, error) {
+	settings := &Ydb_Formats.CsvSettings{}
+	for _, opt := range data.opts {
+		if opt != nil {
+			opt.applyCsvFormatOption(settings)
+		}
+	}
+
+	return &Ydb_Table.BulkUpsertRequest{
+		Table: tableName,
+		DataFormat: &Ydb_Table.BulkUpsertRequest_CsvSettings{
+			CsvSettings: settings,
+		},
+		Data: data.data,
+	}, nil
+}
+
+// BulkUpsertDataCsv makes BulkUpsertData from raw CSV payload
+func BulkUpsertDataCsv(data []byte, opts ...csvFormatOption) bulkUpsertCsv {
+	return bulkUpsertCsv{
+		data: data,
+		opts: opts,
+	}
+}
+
+type (
+	csvHeaderOption    struct{}
+	csvNullValueOption []byte
+	csvDelimiterOption []byte
+	csvSkipRowsOption  uint32
+)
+
+func (csvHeaderOption) applyCsvFormatOption(settings *Ydb_Formats.CsvSettings) {
+	settings.Header = true
+}
+
+// WithCsvHeader marks first not skipped line of CSV payload as header with column names
+func WithCsvHeader() csvFormatOption {
+	return csvHeaderOption{}
+}
+
+func (nullValue csvNullValueOption) applyCsvFormatOption(settings *Ydb_Formats.CsvSettings) {
+	settings.NullValue = nullValue
+}
+
+// WithCsvNullValue defines string value which would be interpreted as NULL
+func WithCsvNullValue(nullValue []byte) csvFormatOption {
+	return csvNullValueOption(nullValue)
+}
+
+func (delimiter csvDelimiterOption) applyCsvFormatOption(settings *Ydb_Formats.CsvSettings) {
+	settings.Delimiter = delimiter
+}
+
+// WithCsvDelimiter defines fields delimiter of CSV payload. Default delimiter is ","
+func WithCsvDelimiter(delimiter []byte) csvFormatOption {
+	return csvDelimiterOption(delimiter)
+}
+
+func (skipRows csvSkipRowsOption) applyCsvFormatOption(settings *Ydb_Formats.CsvSettings) {
+	settings.SkipRows = uint32(skipRows)
+}
+
+// WithCsvSkipRows defines number of rows to skip before CSV data
+//
+// Skipped rows should be present only in the first chunk of CSV file.
+func WithCsvSkipRows(skipRows uint32) csvFormatOption {
+	return csvSkipRowsOption(skipRows)
+}
+
+var _ BulkUpsertData = bulkUpsertArrow{}
+
+type (
+	bulkUpsertArrow struct {
+		data []byte
+		opts []arrowFormatOption
+	}
+	arrowFormatOption interface {
+		applyArrowFormatOption(settings *Ydb_Formats.ArrowBatchSettings)
+	}
+)
+
+func (data bulkUpsertArrow) ToYDB(a *allocator.Allocator, tableName string) (*Ydb_Table.BulkUpsertRequest, error) {
+	settings := &Ydb_Formats.ArrowBatchSettings{}
+	for _, opt := range data.opts {
+		if opt != nil {
+			opt.applyArrowFormatOption(settings)
+		}
+	}
+
+	return &Ydb_Table.BulkUpsertRequest{
+		Table: tableName,
+		DataFormat: &Ydb_Table.BulkUpsertRequest_ArrowBatchSettings{
+			ArrowBatchSettings: settings,
+		},
+		Data: data.data,
+	}, nil
+}
+
+// BulkUpsertDataArrow makes BulkUpsertData from serialized Apache Arrow record batch
+func BulkUpsertDataArrow(data []byte, opts ...arrowFormatOption) bulkUpsertArrow {
+	return bulkUpsertArrow{
+		data: data,
+		opts: opts,
+	}
+}
+
+type arrowSchemaOption []byte
+
+func (schema arrowSchemaOption) applyArrowFormatOption(settings *Ydb_Formats.ArrowBatchSettings) {
+	settings.Schema = schema
+}
+
+// WithArrowSchema defines serialized Apache Arrow schema of record batch
+func WithArrowSchema(schema []byte) arrowFormatOption {
+	return arrowSchemaOption(schema)
+}
@@ -4,7 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
@@ -62,3 +66,56 @@ func TestQueryParameters_String(t *testing.T) {
 		})
 	}
 }
+
+func TestBulkUpsertData(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		data    table.BulkUpsertData
+		request *Ydb_Table.BulkUpsertRequest
+	}{
+		{
+			name: "Csv",
+			data: table.BulkUpsertDataCsv([]byte("skip\nid;val\n1;NULL\n"),
+				table.WithCsvHeader(),
+				table.WithCsvNullValue([]byte("NULL")),
+				table.WithCsvDelimiter([]byte(";")),
+				table.WithCsvSkipRows(1),
+			),
+			request: &Ydb_Table.BulkUpsertRequest{
+				Table: "test",
+				DataFormat: &Ydb_Table.BulkUpsertRequest_CsvSettings{
+					CsvSettings: &Ydb_Formats.CsvSettings{
+						SkipRows:  1,
+						Delimiter: []byte(";"),
+						NullValue: []byte("NULL"),
+						Header:    true,
+					},
+				},
+				Data: []byte("skip\nid;val\n1;NULL\n"),
+			},
+		},
+		{
+			name: "Arrow",
+			data: table.BulkUpsertDataArrow([]byte("data"),
+				table.WithArrowSchema([]byte("schema")),
+			),
+			request: &Ydb_Table.BulkUpsertRequest{
+				Table: "test",
+				DataFormat: &Ydb_Table.BulkUpsertRequest_ArrowBatchSettings{
+					ArrowBatchSettings: &Ydb_Formats.ArrowBatchSettings{
+						Schema: []byte("schema"),
+					},
+				},
+				Data: []byte("data"),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := allocator.New()
+			defer a.Free()
+			request, err := tt.data.ToYDB(a, "test")
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.request, request))
+		})
+	}
+}
@@ -11,6 +11,11 @@ import (
 var errNilValue = errors.New("nil value")
 
 // CastTo try cast value to destination type value
+//
+// Primitive values are cast to pointers to Go native types, optional values to pointers
+// (nil on NULL) or to values (zero value on NULL), List, Set and Tuple values to pointers
+// to slices, Struct values to pointers to Go structs with fields matched by `ydb:"name"` tags
+// or by field names and Dict values to pointers to maps.
 func CastTo(v Value, dst interface{}) error {
 	if v == nil {
 		return xerrors.WithStackTrace(errNilValue)
@@ -91,6 +96,18 @@ func VariantValue(v Value) (name string, idx uint32, _ Value, _ error) {
 	return "", 0, nil, xerrors.WithStackTrace(fmt.Errorf("cannot get variant value from '%s'", v.Type().Yql()))
 }
 
+// TaggedItem returns tag and inner value from abstract tagged Value
+func TaggedItem(v Value) (tag string, _ Value, _ error) {
+	if vv, has := v.(interface {
+		Tag() string
+		Value() Value
+	}); has {
+		return vv.Tag(), vv.Value(), nil
+	}
+
+	return "", nil, xerrors.WithStackTrace(fmt.Errorf("cannot get tagged value from '%s'", v.Type().Yql()))
+}
+
 // DictFields returns dict values from abstract Value
 //
 // Deprecated: use DictValues instead.
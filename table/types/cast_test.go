@@ -87,3 +87,53 @@ func TestToDecimal(t *testing.T) {
 		})
 	}
 }
+
+func TestCastToContainers(t *testing.T) {
+	type episode struct {
+		ID       uint64  `ydb:"episode_id"`
+		Title    string  `ydb:"title"`
+		Comments *string `ydb:"comments"`
+		Skipped  string  `ydb:"-"`
+	}
+	v := ListValue(
+		StructValue(
+			StructFieldValue("episode_id", Uint64Value(1)),
+			StructFieldValue("title", TextValue("a")),
+			StructFieldValue("comments", NullValue(TypeText)),
+		),
+		StructValue(
+			StructFieldValue("episode_id", Uint64Value(2)),
+			StructFieldValue("title", TextValue("b")),
+			StructFieldValue("comments", OptionalValue(TextValue("c"))),
+		),
+	)
+	var episodes []episode
+	require.NoError(t, CastTo(v, &episodes))
+	comments := "c"
+	require.Equal(t, []episode{
+		{ID: 1, Title: "a"},
+		{ID: 2, Title: "b", Comments: &comments},
+	}, episodes)
+
+	var ids []uint64
+	require.NoError(t, CastTo(ListValue(Uint64Value(1), Uint64Value(2)), &ids))
+	require.Equal(t, []uint64{1, 2}, ids)
+
+	var items []string
+	require.NoError(t, CastTo(TupleValue(TextValue("a"), Int32Value(1)), &items))
+	require.Equal(t, []string{"a", "1"}, items)
+
+	require.Error(t, CastTo(ListValue(TextValue("a")), &ids))
+	require.Error(t, CastTo(ListValue(Uint64Value(1)), &episode{}))
+}
+
+func TestCastPg(t *testing.T) {
+	v := PgValue(23, "123")
+	require.True(t, Equal(PgType(23), v.Type()))
+	var s string
+	require.NoError(t, CastTo(v, &s))
+	require.Equal(t, "123", s)
+	var b []byte
+	require.NoError(t, CastTo(PgBinaryValue(17, []byte{1, 2}), &b))
+	require.Equal(t, []byte{1, 2}, b)
+}
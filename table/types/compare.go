@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrNotComparable is returned by CompareValues for values of not comparable types
+var ErrNotComparable = value.ErrNotComparable
+
+// CompareValues compares its operands.
+// It returns -1, 0, 1 if l < r, l == r, l > r. Returns ErrNotComparable if types are not comparable.
+//
+// Comparable types are all integer types, UUID, DyNumber, Float, Double, String, Utf8,
+// Date, Datetime, Timestamp, Interval, Decimal, Tuples, Lists and Structs.
+// Optional values are unwrapped, NULL is less than any non-NULL value.
+// Decimal values are compared with respect to their scales.
+func CompareValues(l, r Value) (int, error) {
+	cmp, err := value.Compare(l, r)
+	if err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	return cmp, nil
+}
+
+// EqualValues reports whether values are equal.
+// Comparable values are equal if CompareValues returns 0, values of other types (Json, Dict and so on)
+// are equal if their types and YQL literals are equal.
+func EqualValues(l, r Value) bool {
+	cmp, err := value.Compare(l, r)
+	if errors.Is(err, ErrNotComparable) {
+		return Equal(l.Type(), r.Type()) && l.Yql() == r.Yql()
+	}
+
+	return err == nil && cmp == 0
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareValues(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		l, r Value
+		cmp  int
+		err  bool
+	}{
+		{
+			name: "Primitives",
+			l:    Uint64Value(1),
+			r:    Uint64Value(2),
+			cmp:  -1,
+		},
+		{
+			name: "Optional",
+			l:    OptionalValue(OptionalValue(TextValue("b"))),
+			r:    TextValue("a"),
+			cmp:  1,
+		},
+		{
+			name: "Null",
+			l:    NullValue(TypeText),
+			r:    TextValue("a"),
+			cmp:  -1,
+		},
+		{
+			name: "DecimalScale",
+			l:    DecimalValueFromBigInt(big.NewInt(150), 22, 2),
+			r:    DecimalValueFromBigInt(big.NewInt(1500000000), 22, 9),
+			cmp:  0,
+		},
+		{
+			name: "Decimal",
+			l:    DecimalValueFromBigInt(big.NewInt(-1), 22, 2),
+			r:    DecimalValueFromBigInt(big.NewInt(1), 35, 9),
+			cmp:  -1,
+		},
+		{
+			name: "NotComparable",
+			l:    Uint64Value(1),
+			r:    TextValue("1"),
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp, err := CompareValues(tt.l, tt.r)
+			if tt.err {
+				require.ErrorIs(t, err, ErrNotComparable)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.cmp, cmp)
+		})
+	}
+}
+
+func TestEqualValues(t *testing.T) {
+	require.True(t, EqualValues(OptionalValue(Int32Value(1)), Int32Value(1)))
+	require.False(t, EqualValues(Int32Value(1), Int32Value(2)))
+	require.True(t, EqualValues(JSONValue(`{}`), JSONValue(`{}`)))
+	require.False(t, EqualValues(JSONValue(`{}`), JSONDocumentValue(`{}`)))
+	require.False(t, EqualValues(Int32Value(1), Int64Value(1)))
+	require.True(t, EqualValues(
+		StructValue(StructFieldValue("a", Int32Value(1))),
+		StructValue(StructFieldValue("a", Int32Value(1))),
+	))
+	require.False(t, EqualValues(
+		StructValue(StructFieldValue("a", Int32Value(1))),
+		StructValue(StructFieldValue("b", Int32Value(1))),
+	))
+}
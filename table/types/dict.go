@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// DictValueFromMap makes Dict<k,v> value from map entries with key and val conversions applied
+//
+// key and val must return values of types k and v. Empty map makes typed empty dict without
+// calls of conversions.
+// Dict values can be scanned into map[K]V destinations with primitive keys.
+func DictValueFromMap[K comparable, V any](k, v Type, m map[K]V, key func(K) Value, val func(V) Value) Value {
+	if len(m) == 0 {
+		return value.ZeroValue(Dict(k, v))
+	}
+	fields := make([]value.DictValueField, 0, len(m))
+	for k, v := range m {
+		fields = append(fields, value.DictValueField{K: key(k), V: val(v)})
+	}
+
+	return value.DictValue(fields...)
+}
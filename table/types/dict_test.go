@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDictValueFromMap(t *testing.T) {
+	v := DictValueFromMap(TypeText, TypeUint64, map[string]uint64{"b": 2, "a": 1}, TextValue, Uint64Value)
+	require.Equal(t, `{"a"u:1ul,"b"u:2ul}`, v.Yql())
+
+	var dst map[string]uint64
+	require.NoError(t, CastTo(v, &dst))
+	require.Equal(t, map[string]uint64{"a": 1, "b": 2}, dst)
+
+	empty := DictValueFromMap(TypeText, TypeUint64, map[string]uint64(nil),
+		func(string) Value { panic("unexpected key conversion call") },
+		func(uint64) Value { panic("unexpected val conversion call") },
+	)
+	require.True(t, Equal(Dict(TypeText, TypeUint64), empty.Type()), empty.Type().Yql())
+
+	require.Error(t, CastTo(v, &[]string{}))
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// JSONValueFromObject makes JSON value from v marshaled with encoding/json
+func JSONValueFromObject(v interface{}) (Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return value.JSONValue(xstring.FromBytes(b)), nil
+}
+
+// JSONDocumentValueFromObject makes JSONDocument value from v marshaled with encoding/json
+func JSONDocumentValueFromObject(v interface{}) (Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return value.JSONDocumentValue(xstring.FromBytes(b)), nil
+}
+
+// JSONObject returns scan destination which unmarshals JSON or JSONDocument value into dst
+// with encoding/json. NULL value leaves dst unchanged.
+//
+// Example:
+//
+//	var payload struct{ ID uint64 `json:"id"` }
+//	err := res.ScanNamed(named.Required("payload", types.JSONObject(&payload)))
+func JSONObject(dst interface{}) json.Unmarshaler {
+	return jsonObject{dst: dst}
+}
+
+type jsonObject struct {
+	dst interface{}
+}
+
+func (o jsonObject) UnmarshalJSON(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(b, o.dst); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
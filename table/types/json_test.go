@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONObject(t *testing.T) {
+	type payload struct {
+		ID   uint64   `json:"id"`
+		Tags []string `json:"tags"`
+	}
+	v, err := JSONValueFromObject(payload{ID: 1, Tags: []string{"a"}})
+	require.NoError(t, err)
+	require.Equal(t, `Json(@@{"id":1,"tags":["a"]}@@)`, v.Yql())
+
+	v, err = JSONDocumentValueFromObject(payload{ID: 2})
+	require.NoError(t, err)
+	require.Equal(t, `JsonDocument(@@{"id":2,"tags":null}@@)`, v.Yql())
+
+	_, err = JSONValueFromObject(make(chan int))
+	require.Error(t, err)
+
+	var dst payload
+	require.NoError(t, JSONObject(&dst).UnmarshalJSON([]byte(`{"id":3,"tags":["b","c"]}`)))
+	require.Equal(t, payload{ID: 3, Tags: []string{"b", "c"}}, dst)
+	require.NoError(t, JSONObject(&dst).UnmarshalJSON(nil))
+	require.Equal(t, payload{ID: 3, Tags: []string{"b", "c"}}, dst)
+	require.Error(t, JSONObject(&dst).UnmarshalJSON([]byte(`{`)))
+}
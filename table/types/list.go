@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// ListValueFromSlice makes List<t> value from slice items with conv applied to each item
+//
+// conv must return values of type t. Empty slice makes typed empty list without calls of conv.
+func ListValueFromSlice[T any](t Type, items []T, conv func(T) Value) Value {
+	if len(items) == 0 {
+		return value.ZeroValue(List(t))
+	}
+	values := make([]Value, len(items))
+	for i := range items {
+		values[i] = conv(items[i])
+	}
+
+	return value.ListValue(values...)
+}
+
+// ListValueFromUint64Slice makes List<Uint64> value from slice of uint64
+func ListValueFromUint64Slice(items []uint64) Value {
+	if len(items) == 0 {
+		return value.ZeroValue(List(TypeUint64))
+	}
+	values := make([]Value, len(items))
+	for i := range items {
+		values[i] = value.Uint64Value(items[i])
+	}
+
+	return value.ListValue(values...)
+}
+
+// ListValueFromTextSlice makes List<Utf8> value from slice of strings
+func ListValueFromTextSlice(items []string) Value {
+	if len(items) == 0 {
+		return value.ZeroValue(List(TypeText))
+	}
+	values := make([]Value, len(items))
+	for i := range items {
+		values[i] = value.TextValue(items[i])
+	}
+
+	return value.ListValue(values...)
+}
+
+// ListValueFromTimestampSlice makes List<Timestamp> value from slice of time.Time
+func ListValueFromTimestampSlice(items []time.Time) Value {
+	if len(items) == 0 {
+		return value.ZeroValue(List(TypeTimestamp))
+	}
+	values := make([]Value, len(items))
+	for i := range items {
+		values[i] = value.TimestampValueFromTime(items[i])
+	}
+
+	return value.ListValue(values...)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListValueFromSlice(t *testing.T) {
+	ts := time.Unix(1, 0)
+	for _, tt := range []struct {
+		name string
+		v    Value
+		exp  Value
+	}{
+		{
+			name: "Generic",
+			v:    ListValueFromSlice(TypeInt32, []int{1, 2}, func(v int) Value { return Int32Value(int32(v)) }),
+			exp:  ListValue(Int32Value(1), Int32Value(2)),
+		},
+		{
+			name: "Uint64",
+			v:    ListValueFromUint64Slice([]uint64{1, 2}),
+			exp:  ListValue(Uint64Value(1), Uint64Value(2)),
+		},
+		{
+			name: "Text",
+			v:    ListValueFromTextSlice([]string{"a", "b"}),
+			exp:  ListValue(TextValue("a"), TextValue("b")),
+		},
+		{
+			name: "Timestamp",
+			v:    ListValueFromTimestampSlice([]time.Time{ts}),
+			exp:  ListValue(TimestampValueFromTime(ts)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp.Yql(), tt.v.Yql())
+			require.True(t, Equal(tt.exp.Type(), tt.v.Type()))
+		})
+	}
+	t.Run("Empty", func(t *testing.T) {
+		for _, tt := range []struct {
+			v   Value
+			exp Type
+		}{
+			{ListValueFromSlice(TypeInt32, []int(nil), func(int) Value { panic("unexpected conv call") }), List(TypeInt32)},
+			{ListValueFromUint64Slice(nil), List(TypeUint64)},
+			{ListValueFromTextSlice(nil), List(TypeText)},
+			{ListValueFromTimestampSlice(nil), List(TypeTimestamp)},
+		} {
+			require.True(t, Equal(tt.exp, tt.v.Type()), tt.v.Type().Yql())
+		}
+	})
+}
@@ -58,6 +58,18 @@ func Dict(k, v Type) Type {
 	return types.NewDict(k, v)
 }
 
+// Tagged makes Tagged<t,'tag'> type
+func Tagged(tag string, t Type) Type {
+	return types.NewTagged(tag, t)
+}
+
+// PgType makes PostgreSQL type with given OID
+//
+// OIDs of PostgreSQL types are listed in https://github.com/postgres/postgres/blob/master/src/include/catalog/pg_type.dat
+func PgType(oid uint32) Type {
+	return types.PgType{OID: oid}
+}
+
 func VariantStruct(opts ...StructOption) Type {
 	var s tStructType
 	for _, opt := range opts {
@@ -133,6 +145,7 @@ func WriteTypeStringTo(buf *bytes.Buffer, t Type) { //nolint: interfacer
 }
 
 type (
-	RawValue = scanner.RawValue
-	Scanner  = scanner.Scanner
+	RawValue        = scanner.RawValue
+	Scanner         = scanner.Scanner
+	YSONUnmarshaler = scanner.YSONUnmarshaler
 )
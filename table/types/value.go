@@ -6,6 +6,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
 
@@ -62,6 +63,8 @@ func TzDatetimeValue(v string) Value { return value.TzDatetimeValue(v) }
 func TzTimestampValue(v string) Value { return value.TzTimestampValue(v) }
 
 // DateValueFromTime makes Date value from time.Time
+// Date precision is one day: time of day is truncated, days are counted since unix epoch
+// in UTC. Scanning of Date into *time.Time returns the instant of UTC midnight of the day.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -70,6 +73,7 @@ func DateValueFromTime(t time.Time) Value {
 }
 
 // DatetimeValueFromTime makes Datetime value from time.Time
+// Datetime precision is one second: fractional seconds are truncated.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -78,6 +82,7 @@ func DatetimeValueFromTime(t time.Time) Value {
 }
 
 // TimestampValueFromTime makes Timestamp value from time.Time
+// Timestamp precision is one microsecond: nanoseconds are truncated.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -86,6 +91,8 @@ func TimestampValueFromTime(t time.Time) Value {
 }
 
 // IntervalValueFromDuration makes Interval value from time.Duration
+// Interval precision is one microsecond: nanoseconds are truncated towards zero.
+// Casting or scanning of Interval into time.Duration fails if interval is out of time.Duration range.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -94,6 +101,8 @@ func IntervalValueFromDuration(v time.Duration) Value {
 }
 
 // TzDateValueFromTime makes TzDate value from time.Time
+// Time location name is kept in value, so scanning into *time.Time returns time in the same location.
+// Local and unnamed locations are converted to UTC.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -102,6 +111,8 @@ func TzDateValueFromTime(t time.Time) Value {
 }
 
 // TzDatetimeValueFromTime makes TzDatetime value from time.Time
+// Time location name is kept in value, so scanning into *time.Time returns time in the same location.
+// Local and unnamed locations are converted to UTC.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -110,6 +121,8 @@ func TzDatetimeValueFromTime(t time.Time) Value {
 }
 
 // TzTimestampValueFromTime makes TzTimestamp value from time.Time
+// Time location name is kept in value, so scanning into *time.Time returns time in the same location.
+// Local and unnamed locations are converted to UTC.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -189,6 +202,17 @@ func DecimalValueFromBigInt(v *big.Int, precision, scale uint32) Value {
 	return value.DecimalValueFromBigInt(v, precision, scale)
 }
 
+// DecimalValueFromRat creates decimal value from rational v with given precision and scale.
+// It returns error if v cannot be represented exactly as decimal with given precision and scale.
+func DecimalValueFromRat(v *big.Rat, precision, scale uint32) (Value, error) {
+	x, err := decimal.FromRat(v, precision, scale)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return value.DecimalValueFromBigInt(x, precision, scale), nil
+}
+
 func TupleValue(vs ...Value) Value {
 	return value.TupleValue(vs...)
 }
@@ -247,6 +271,23 @@ func DictValue(opts ...DictValueOption) Value {
 	return value.DictValue(p.fields...)
 }
 
+// TaggedValue makes value of Tagged type with inner value v
+func TaggedValue(tag string, v Value) Value {
+	return value.TaggedValue(tag, v)
+}
+
+// PgValue makes value of PostgreSQL type with given OID from text representation
+//
+// Values of PostgreSQL types can be scanned into string and []byte destinations
+func PgValue(oid uint32, text string) Value {
+	return value.PgValue(oid, text)
+}
+
+// PgBinaryValue makes value of PostgreSQL type with given OID from binary representation
+func PgBinaryValue(oid uint32, data []byte) Value {
+	return value.PgBinaryValue(oid, data)
+}
+
 func VariantValueStruct(v Value, name string, variantT Type) Value {
 	return value.VariantValueStruct(v, name, variantT)
 }
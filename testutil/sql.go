@@ -0,0 +1,264 @@
+package testutil
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+var (
+	ErrUnexpectedQuery   = errors.New("testutil: unexpected query")
+	ErrUnfulfilledQuery  = errors.New("testutil: there are unfulfilled expected queries")
+	errMalformedRow      = errors.New("testutil: count of values not equal to count of columns")
+	errUnexpectedArgs    = errors.New("testutil: unexpected query args")
+	errUnsupportedMethod = errors.New("testutil: unsupported method")
+)
+
+type (
+	// SQLConnector is an in-memory fake of ydb database/sql driver for unit tests of code
+	// which uses database/sql without YDB instance. Use sql.OpenDB(connector) for making *sql.DB.
+	//
+	// Each query or exec call takes next expectation in order of registration and fails with
+	// ErrUnexpectedQuery if there are no expectations or query doesn't match. Rows of scripted
+	// result sets are converted to Go values same as in ydb database/sql driver.
+	// Transactions are fake: queries in transaction take expectations same as without transaction.
+	SQLConnector struct {
+		mu           sync.Mutex
+		expectations []*SQLExpectation
+	}
+	// SQLExpectation describes expected query and its result
+	SQLExpectation struct {
+		query string
+		args  []interface{}
+		sets  []*SQLResultSet
+		err   error
+	}
+	// SQLResultSet is a scripted result set of expected query
+	SQLResultSet struct {
+		columns []options.Column
+		rows    [][]value.Value
+	}
+)
+
+// NewSQLConnector makes fake database/sql connector without expectations
+func NewSQLConnector() *SQLConnector {
+	return &SQLConnector{}
+}
+
+// ExpectQuery adds expectation of query or exec call with query which contains substring query.
+// Empty query matches any query.
+func (c *SQLConnector) ExpectQuery(query string) *SQLExpectation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &SQLExpectation{query: query}
+	c.expectations = append(c.expectations, e)
+
+	return e
+}
+
+// ExpectationsWereMet returns error if some of expected queries were not called
+func (c *SQLConnector) ExpectationsWereMet() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.expectations) > 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: next expected query %q",
+			ErrUnfulfilledQuery, c.expectations[0].query,
+		))
+	}
+
+	return nil
+}
+
+// WithArgs sets expected values of query args
+func (e *SQLExpectation) WithArgs(args ...interface{}) *SQLExpectation {
+	e.args = args
+
+	return e
+}
+
+// WillReturnResultSets sets result sets of expected query
+func (e *SQLExpectation) WillReturnResultSets(sets ...*SQLResultSet) *SQLExpectation {
+	e.sets = sets
+
+	return e
+}
+
+// WillReturnError makes expected query fail with err
+func (e *SQLExpectation) WillReturnError(err error) *SQLExpectation {
+	e.err = err
+
+	return e
+}
+
+// NewSQLResultSet makes scripted result set with columns
+func NewSQLResultSet(columns ...options.Column) *SQLResultSet {
+	return &SQLResultSet{columns: columns}
+}
+
+// AddRow appends row with values of columns to result set
+func (s *SQLResultSet) AddRow(values ...value.Value) *SQLResultSet {
+	if len(values) != len(s.columns) {
+		panic(fmt.Sprintf("%v: %d != %d", errMalformedRow, len(values), len(s.columns)))
+	}
+	s.rows = append(s.rows, values)
+
+	return s
+}
+
+func (s *SQLResultSet) toYDB(a *allocator.Allocator) *Ydb.ResultSet {
+	set := &Ydb.ResultSet{
+		Columns: make([]*Ydb.Column, 0, len(s.columns)),
+		Rows:    make([]*Ydb.Value, 0, len(s.rows)),
+	}
+	for _, c := range s.columns {
+		set.Columns = append(set.Columns, &Ydb.Column{
+			Name: c.Name,
+			Type: types.TypeToYDB(c.Type, a),
+		})
+	}
+	for _, row := range s.rows {
+		items := make([]*Ydb.Value, 0, len(row))
+		for _, v := range row {
+			items = append(items, value.ToYDB(v, a).GetValue())
+		}
+		set.Rows = append(set.Rows, &Ydb.Value{Items: items})
+	}
+
+	return set
+}
+
+func (c *SQLConnector) Connect(context.Context) (driver.Conn, error) {
+	return &sqlConn{c: c}, nil
+}
+
+func (c *SQLConnector) Driver() driver.Driver {
+	return c
+}
+
+func (c *SQLConnector) Open(string) (driver.Conn, error) {
+	return &sqlConn{c: c}, nil
+}
+
+func (c *SQLConnector) next(query string, args []driver.NamedValue) (*SQLExpectation, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.expectations) == 0 {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", ErrUnexpectedQuery, query))
+	}
+	e := c.expectations[0]
+	if !strings.Contains(query, e.query) {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q, expected query %q", ErrUnexpectedQuery, query, e.query))
+	}
+	if e.args != nil {
+		values := make([]interface{}, 0, len(args))
+		for _, arg := range args {
+			values = append(values, arg.Value)
+		}
+		if !reflect.DeepEqual(values, e.args) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %v, expected %v", errUnexpectedArgs, values, e.args))
+		}
+	}
+	c.expectations = c.expectations[1:]
+
+	return e, nil
+}
+
+type sqlConn struct {
+	c *SQLConnector
+}
+
+var (
+	_ driver.Conn              = &sqlConn{}
+	_ driver.ConnBeginTx       = &sqlConn{}
+	_ driver.ExecerContext     = &sqlConn{}
+	_ driver.QueryerContext    = &sqlConn{}
+	_ driver.NamedValueChecker = &sqlConn{}
+	_ driver.Tx                = sqlTx{}
+)
+
+func (c *sqlConn) CheckNamedValue(*driver.NamedValue) error {
+	// allows all values same as ydb database/sql driver
+	return nil
+}
+
+func (c *sqlConn) Prepare(string) (driver.Stmt, error) {
+	return nil, xerrors.WithStackTrace(errUnsupportedMethod)
+}
+
+func (c *sqlConn) Close() error {
+	return nil
+}
+
+func (c *sqlConn) Begin() (driver.Tx, error) {
+	return sqlTx{}, nil
+}
+
+func (c *sqlConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return sqlTx{}, nil
+}
+
+func (c *sqlConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	e, err := c.c.next(query, args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	// values are used by rows after return, so allocator is not freed
+	a := allocator.New()
+	sets := make([]*Ydb.ResultSet, 0, len(e.sets))
+	for _, s := range e.sets {
+		sets = append(sets, s.toYDB(a))
+	}
+	if len(sets) == 0 {
+		sets = append(sets, &Ydb.ResultSet{})
+	}
+
+	return xsql.NewRows(scanner.NewUnary(sets, nil)), nil
+}
+
+func (c *sqlConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	e, err := c.c.next(query, args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	return driver.ResultNoRows, nil
+}
+
+type sqlTx struct{}
+
+func (sqlTx) Commit() error {
+	return nil
+}
+
+func (sqlTx) Rollback() error {
+	return nil
+}
@@ -0,0 +1,69 @@
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestSQLConnector(t *testing.T) {
+	ctx := context.Background()
+	connector := NewSQLConnector()
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	errOverloaded := errors.New("overloaded")
+	connector.ExpectQuery("SELECT").
+		WithArgs(uint64(1)).
+		WillReturnResultSets(
+			NewSQLResultSet(
+				options.Column{Name: "id", Type: types.TypeUint64},
+				options.Column{Name: "title", Type: types.Optional(types.TypeText)},
+			).
+				AddRow(types.Uint64Value(1), types.OptionalValue(types.TextValue("IT Crowd"))).
+				AddRow(types.Uint64Value(2), types.NullValue(types.TypeText)),
+		)
+	connector.ExpectQuery("UPSERT").WillReturnError(errOverloaded)
+	connector.ExpectQuery("DELETE")
+
+	rows, err := db.QueryContext(ctx, "SELECT id, title FROM series WHERE id >= $id", sql.Named("id", uint64(1)))
+	require.NoError(t, err)
+	columnTypes, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, "Optional<Utf8>", columnTypes[1].DatabaseTypeName())
+	var (
+		ids    []uint64
+		titles []*string
+	)
+	for rows.Next() {
+		var (
+			id    uint64
+			title *string
+		)
+		require.NoError(t, rows.Scan(&id, &title))
+		ids = append(ids, id)
+		titles = append(titles, title)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []uint64{1, 2}, ids)
+	require.Equal(t, "IT Crowd", *titles[0])
+	require.Nil(t, titles[1])
+
+	_, err = db.ExecContext(ctx, "UPSERT INTO series (id) VALUES (3)")
+	require.ErrorIs(t, err, errOverloaded)
+	require.Error(t, connector.ExpectationsWereMet())
+
+	_, err = db.ExecContext(ctx, "SELECT 1")
+	require.ErrorIs(t, err, ErrUnexpectedQuery)
+
+	_, err = db.ExecContext(ctx, "DELETE FROM series")
+	require.NoError(t, err)
+	require.NoError(t, connector.ExpectationsWereMet())
+}
@@ -15,6 +15,9 @@ type (
 	Retry struct {
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnRetry func(RetryLoopStartInfo) func(RetryLoopDoneInfo)
+
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnCircuitBreakerStateChange func(RetryCircuitBreakerStateChangeInfo)
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	RetryLoopStartInfo struct {
@@ -35,4 +38,9 @@ type (
 		Attempts int
 		Error    error
 	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetryCircuitBreakerStateChangeInfo struct {
+		From string
+		To   string
+	}
 )
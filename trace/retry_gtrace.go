@@ -68,6 +68,25 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 			}
 		}
 	}
+	{
+		h1 := t.OnCircuitBreakerStateChange
+		h2 := x.OnCircuitBreakerStateChange
+		ret.OnCircuitBreakerStateChange = func(r RetryCircuitBreakerStateChangeInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(r)
+			}
+			if h2 != nil {
+				h2(r)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopDoneInfo) {
@@ -85,6 +104,13 @@ func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopDoneInfo) {
 	}
 	return res
 }
+func (t *Retry) onCircuitBreakerStateChange(r RetryCircuitBreakerStateChangeInfo) {
+	fn := t.OnCircuitBreakerStateChange
+	if fn == nil {
+		return
+	}
+	fn(r)
+}
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func RetryOnRetry(t *Retry, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(attempts int, _ error) {
 	var p RetryLoopStartInfo
@@ -101,3 +127,10 @@ func RetryOnRetry(t *Retry, c *context.Context, call call, label string, idempot
 		res(p)
 	}
 }
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func RetryOnCircuitBreakerStateChange(t *Retry, from string, to string) {
+	var p RetryCircuitBreakerStateChangeInfo
+	p.From = from
+	p.To = to
+	t.onCircuitBreakerStateChange(p)
+}
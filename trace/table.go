@@ -180,7 +180,11 @@ type (
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TablePrepareDataQueryDoneInfo struct {
 		Result tableDataQuery
-		Error  error
+		// CacheHit is true if statement was taken from session prepared statements cache
+		CacheHit bool
+		// CacheSize is a number of statements in session prepared statements cache
+		CacheSize int
+		Error     error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableExecuteDataQueryStartInfo struct {
@@ -345,8 +349,13 @@ type (
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TablePoolStateChangeInfo struct {
-		Size  int
-		Event string
+		Size             int
+		Limit            int
+		Idle             int
+		InUse            int
+		WaitQ            int
+		CreateInProgress int
+		Event            string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TablePoolSessionNewStartInfo struct {
@@ -1336,16 +1336,18 @@ func TableOnSessionBulkUpsert(t *Table, c *context.Context, call call, session t
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session tableSessionInfo, query string) func(result tableDataQuery, _ error) {
+func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session tableSessionInfo, query string) func(result tableDataQuery, cacheHit bool, cacheSize int, _ error) {
 	var p TablePrepareDataQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
 	res := t.onSessionQueryPrepare(p)
-	return func(result tableDataQuery, e error) {
+	return func(result tableDataQuery, cacheHit bool, cacheSize int, e error) {
 		var p TablePrepareDataQueryDoneInfo
 		p.Result = result
+		p.CacheHit = cacheHit
+		p.CacheSize = cacheSize
 		p.Error = e
 		res(p)
 	}
@@ -1490,9 +1492,14 @@ func TableOnTxRollback(t *Table, c *context.Context, call call, session tableSes
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnPoolStateChange(t *Table, size int, event string) {
+func TableOnPoolStateChange(t *Table, size int, limit int, idle int, inUse int, waitQ int, createInProgress int, event string) {
 	var p TablePoolStateChangeInfo
 	p.Size = size
+	p.Limit = limit
+	p.Idle = idle
+	p.InUse = inUse
+	p.WaitQ = waitQ
+	p.CreateInProgress = createInProgress
 	p.Event = event
 	t.onPoolStateChange(p)
 }
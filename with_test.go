@@ -3,6 +3,8 @@ package ydb //nolint:testpackage
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -10,6 +12,7 @@ import (
 	"encoding/pem"
 	"math/big"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -151,3 +154,63 @@ func TestWithCertificatesCached(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestWithClientCertificateFromFile(t *testing.T) {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2023),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privKey.PublicKey, privKey)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privKey)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: certBytes,
+	}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: keyBytes,
+	}), 0o600))
+
+	ctx := context.TODO()
+	t.Run("ok", func(t *testing.T) {
+		db, err := newConnectionFromOptions(ctx,
+			WithClientCertificateFromFile(certFile, keyFile),
+			withConnPool(conn.NewPool(context.Background(), config.New())), //nolint:contextcheck
+		)
+		require.NoError(t, err)
+		require.Len(t, db.config.TLSConfig().Certificates, 1)
+		require.Equal(t, certBytes, db.config.TLSConfig().Certificates[0].Certificate[0])
+	})
+	t.Run("no key", func(t *testing.T) {
+		_, err := newConnectionFromOptions(ctx,
+			WithClientCertificateFromFile(certFile, filepath.Join(dir, "unknown.key")),
+			withConnPool(conn.NewPool(context.Background(), config.New())), //nolint:contextcheck
+		)
+		require.Error(t, err)
+	})
+}
+
+func TestWithGrpcCompression(t *testing.T) {
+	ctx := context.TODO()
+	_, err := newConnectionFromOptions(ctx,
+		WithGrpcCompression(config.GrpcCompressionGzip),
+		withConnPool(conn.NewPool(context.Background(), config.New())), //nolint:contextcheck
+	)
+	require.NoError(t, err)
+	_, err = newConnectionFromOptions(ctx,
+		WithGrpcCompression("unknown"),
+		withConnPool(conn.NewPool(context.Background(), config.New())), //nolint:contextcheck
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package ydb
+
+import (
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// WriteValueYQL writes value as YQL literal (such as `[1ul,2ul]` or `Just("a"u)`)
+// which can be pasted into query text for reproducing query in console.
+//
+// Use table.QueryParameters.Yql() for writing all query parameters as YQL statements.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WriteValueYQL(w io.Writer, v value.Value) error {
+	if _, err := io.WriteString(w, v.Yql()); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package ydb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestWriteValueYQL(t *testing.T) {
+	for _, tt := range []struct {
+		v   types.Value
+		exp string
+	}{
+		{
+			v:   types.Uint64Value(1),
+			exp: "1ul",
+		},
+		{
+			v:   types.OptionalValue(types.TextValue("a")),
+			exp: "Just(\"a\"u)",
+		},
+		{
+			v:   types.ListValue(types.Int32Value(1), types.Int32Value(2)),
+			exp: "[1,2]",
+		},
+	} {
+		t.Run(tt.exp, func(t *testing.T) {
+			var b strings.Builder
+			require.NoError(t, WriteValueYQL(&b, tt.v))
+			require.Equal(t, tt.exp, b.String())
+		})
+	}
+}